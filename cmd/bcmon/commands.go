@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/config"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
+	appcloser "git.web3gate.ru/web3/nft/GraphForge/pkg/app_closer"
+	"git.web3gate.ru/web3/nft/GraphForge/pkg/logger"
+	"git.web3gate.ru/web3/nft/GraphForge/pkg/pgsql/pgconnector"
+	"go.uber.org/zap"
+	"math/big"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// backfillCommand rescans an explicit block range of a network:
+//
+//	bcmon backfill -network sepolia -from 7000000 -to 7001000 [-chunk 1000] [-dry-run]
+//
+// It returns the error that stopped it once the connections are closed.
+func backfillCommand(args []string) error {
+	fs := flag.NewFlagSet("backfill", flag.ExitOnError)
+	network := fs.String("network", "", "network name from the config")
+	from := fs.Int64("from", -1, "first block to scan")
+	to := fs.Int64("to", -1, "last block to scan")
+	chunk := fs.Int64("chunk", 1000, "blocks scanned at once")
+	dryRun := fs.Bool("dry-run", false, "print the contracts that would be registered without saving them")
+	_ = fs.Parse(args)
+
+	if *network == "" || *from < 0 || *to < *from || *chunk <= 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	c, log, closeAll := connect(ctx, *network)
+	defer closeAll()
+
+	for start := *from; start <= *to; start += *chunk {
+		end := min(start+*chunk-1, *to)
+
+		contracts, err := c.producer.Scan(ctx, big.NewInt(start), big.NewInt(end))
+		if err != nil {
+			log.Error("failed to scan blocks", zap.Int64("from", start), zap.Int64("to", end), zap.Error(err))
+			return err
+		}
+
+		if *dryRun {
+			inspect(ctx, c, contracts)
+			continue
+		}
+
+		if err := c.supervisor.Register(ctx, contracts); err != nil {
+			log.Error("failed to register contracts", zap.Int64("from", start), zap.Int64("to", end), zap.Error(err))
+			return err
+		}
+
+		log.Info("rescanned", zap.Int64("from", start), zap.Int64("to", end), zap.Int("candidates", len(contracts)))
+	}
+
+	return nil
+}
+
+// rescanCommand re-runs detection for a list of addresses:
+//
+//	bcmon rescan -network sepolia -addresses 0x...,0x... [-dry-run]
+//
+// It returns the error that stopped it once the connections are closed.
+func rescanCommand(args []string) error {
+	fs := flag.NewFlagSet("rescan", flag.ExitOnError)
+	network := fs.String("network", "", "network name from the config")
+	addresses := fs.String("addresses", "", "comma separated contract addresses")
+	dryRun := fs.Bool("dry-run", false, "print the contracts that would be registered without saving them")
+	_ = fs.Parse(args)
+
+	if *network == "" || *addresses == "" {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	c, log, closeAll := connect(ctx, *network)
+	defer closeAll()
+
+	for _, address := range strings.Split(*addresses, ",") {
+		contract := &entity.Contract{
+			Network: *network,
+			ChainID: entity.Atoi[*network],
+			Address: strings.TrimSpace(address),
+		}
+
+		if err := c.explorer.LoadInfo(ctx, contract); err != nil {
+			fmt.Printf("%s\trejected: %v\n", contract.Address, err)
+			continue
+		}
+
+		if *dryRun {
+			report(ctx, c, contract)
+			continue
+		}
+
+		block, err := strconv.ParseInt(contract.Deployment.BlockNumber, 10, 64)
+		if err != nil {
+			log.Error("incorrect deployment block", zap.String("address", contract.Address), zap.Error(err))
+			continue
+		}
+		contract.Found(big.NewInt(block))
+
+		if err := c.supervisor.Register(ctx, []*entity.Contract{contract}); err != nil {
+			log.Error("failed to register contract", zap.String("address", contract.Address), zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inspect prints which of the candidates would be registered.
+func inspect(ctx context.Context, c *components, contracts []*entity.Contract) {
+	seen := make(map[string]struct{})
+	for _, contract := range contracts {
+		if _, ok := seen[contract.Address]; ok {
+			continue
+		}
+		seen[contract.Address] = struct{}{}
+
+		switch contract.Type {
+		case entity.ERC1155Type:
+		case entity.ERC20Type:
+			if !c.supervisor.TracksTokens() || !c.explorer.IsERC20(ctx, contract) {
+				continue
+			}
+		default:
+			if !c.explorer.IsERC721(ctx, contract) {
+				continue
+			}
+		}
+
+		if err := c.explorer.LoadInfo(ctx, contract); err != nil {
+			fmt.Printf("%s\tblock %s\trejected: %v\n", contract.Address, contract.FoundAt(), err)
+			continue
+		}
+
+		report(ctx, c, contract)
+	}
+}
+
+func report(ctx context.Context, c *components, contract *entity.Contract) {
+	state := "new"
+	if c.storage.Initialized(ctx, contract) {
+		state = "registered"
+	}
+
+	fmt.Printf("%s\t%s\t%s\tdeployed at %s by %s\n", contract.Address, contract.Type, state, contract.Deployment.BlockNumber, contract.Deployment.ContractCreator)
+}
+
+// connect sets up a single network pipeline for a one-off command.
+func connect(ctx context.Context, name string) (*components, *zap.Logger, func()) {
+	log := logger.FromEnv("[graph-forge]")
+	cfg := config.CreateConfig()
+	closer := appcloser.InitCloser(log)
+
+	var network *config.Network
+	for i := range cfg.Networks {
+		if cfg.Networks[i].Name == name {
+			network = &cfg.Networks[i]
+		}
+	}
+	if network == nil {
+		log.Fatal("unknown network", zap.String("network", name))
+	}
+
+	pgConnector, err := pgconnector.CreateConnection(ctx,
+		cfg.Db.Postgres.GetDsn(),
+		cfg.Db.Postgres.GetMaxOpenConns(),
+		cfg.Db.Postgres.GetIdleConns(),
+		cfg.Db.Postgres.GetIdleTime(),
+		closer)
+	if err != nil {
+		log.Fatal("pgConnector creation error", zap.Error(err))
+	}
+
+	client := upstreams(*network, log)
+	closer.AddCloser(client.Close, network.Name)
+
+	clients := map[string]interfaces.Client{network.Name: client}
+
+	sources := deploymentSources([]config.Network{*network}, log)
+
+	return assemble(ctx, cfg, *network, client, clients, sources, pgConnector, log), log, closer.CloseAll
+}
@@ -2,22 +2,28 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	application "git.web3gate.ru/web3/nft/GraphForge/internal/app"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/config"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/core/backfill"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/core/deployment"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/core/explorer"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/core/graph"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/core/producer"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/core/rpcpool"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/grpc"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/storage"
 	appcloser "git.web3gate.ru/web3/nft/GraphForge/pkg/app_closer"
 	"git.web3gate.ru/web3/nft/GraphForge/pkg/logger"
 	"git.web3gate.ru/web3/nft/GraphForge/pkg/pgsql/pgconnector"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"io"
 	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -36,14 +42,109 @@ func init() {
 }
 
 func main() {
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT)
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "backfill":
+			if err := backfillCommand(os.Args[2:]); err != nil {
+				os.Exit(1)
+			}
+			return
+		case "rescan":
+			if err := rescanCommand(os.Args[2:]); err != nil {
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	serve()
+}
+
+type components struct {
+	supervisor *application.Supervisor
+	producer   *producer.Producer
+	explorer   *explorer.Explorer
+	storage    interfaces.Storage
+}
+
+// assemble wires the indexing pipeline of a single network.
+func assemble(ctx context.Context, cfg *config.Config, network config.Network, client interfaces.Client, clients map[string]interfaces.Client, sources map[string][]interfaces.DeploymentSource, pgConnector pgconnector.ConnectionManager, log *zap.Logger) *components {
+	log = log.With(zap.String("network", network.Name))
+	repo := storage.NewStorage(ctx, pgConnector, log)
+	theGraph := graph.NewGraph(network.Name, cfg.GetSubgraphPath(), cfg.GetGraphNodeURL(), log)
+	prod := producer.NewProducer(client, log, network.Name, producer.Settings{
+		ReorgDepth:     network.GetReorgDepth(),
+		Confirmations:  network.Confirmations,
+		Finality:       network.GetFinality(),
+		ScanMode:       network.GetScanMode(),
+		LogRange:       network.LogRange,
+		FollowURL:      network.GetWSURL(),
+		KnownAddresses: network.KnownAddresses,
+	})
+	detect := explorer.NewTokenDetector(clients, sources, log)
+	engine := backfill.NewEngine(prod, repo, log, entity.Atoi[network.Name], network.GetBackfillChunk(), network.GetBackfillWorkers())
+
+	app := application.NewSupervisor(
+		detect,
+		prod,
+		repo,
+		theGraph,
+		engine,
+		log,
+		entity.Atoi[network.Name],
+		network.GetBackfillFrom(),
+		network.GetMaxFailures(),
+		network.GetExceptionTTL(),
+		network.TrackERC20,
+		network.GetMetadataRefresh(),
+	)
+
+	return &components{supervisor: app, producer: prod, explorer: detect, storage: repo}
+}
+
+// deploymentSources creates the deployment sources of the networks.
+// A source configured the same way for several networks is created once, so they share its rate limit.
+func deploymentSources(networks []config.Network, log *zap.Logger) map[string][]interfaces.DeploymentSource {
+	created := make(map[string]interfaces.DeploymentSource)
+	sources := make(map[string][]interfaces.DeploymentSource, len(networks))
+
+	for _, network := range networks {
+		for _, s := range network.DeploymentSources {
+			settings := deployment.Settings{Provider: s.Provider, URL: s.URL, APIKeys: s.GetAPIKeys(), RPS: s.RPS}
+			same := fmt.Sprintf("%s %s %v %v", settings.Provider, settings.URL, settings.APIKeys, settings.RPS)
+
+			source, ok := created[same]
+			if !ok {
+				var err error
+				if source, err = deployment.New(settings, log); err != nil {
+					log.Fatal("invalid deployment source", zap.String("network", network.Name), zap.Error(err))
+				}
+				created[same] = source
+			}
+			sources[network.Name] = append(sources[network.Name], source)
+		}
+	}
+
+	return sources
+}
+
+// upstreams pools the upstreams of a network.
+func upstreams(network config.Network, log *zap.Logger) *rpcpool.Pool {
+	return rpcpool.NewPool(network.Name, network.GetUpstreamURLs(), rpcpool.Limits{
+		RPS:         network.UpstreamRPS,
+		Concurrency: network.UpstreamConcurrency,
+	}, log)
+}
+
+func serve() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
 	log := logger.FromEnv("[graph-forge]")
 
 	cfg := config.CreateConfig()
 
-	closer := appcloser.InitCloser(nil)
+	closer := appcloser.InitCloser(log)
 
 	pgConnector, err := pgconnector.CreateConnection(ctx,
 		cfg.Db.Postgres.GetDsn(),
@@ -55,39 +156,31 @@ func main() {
 		log.Panic("pgConnector creation error", zap.Any("err", err))
 	}
 
-	clients := make(map[string]*ethclient.Client)
+	networks := application.Networks{}
+	clients := make(map[string]interfaces.Client)
+	sources := deploymentSources(cfg.Networks, log)
 	for _, network := range cfg.Networks {
-		client, err := ethclient.Dial(network.UpstreamURL)
-		if err != nil {
-			log.Panic("ethclient.Dial error", zap.Any("err", err))
-			break
-		}
+		client := upstreams(network, log)
+		closer.AddCloser(client.Close, network.Name+" upstreams")
 		clients[network.Name] = client
-
-		log := log.With(zap.String("network", network.Name))
-		repo := storage.NewStorage(ctx, pgConnector, log)
-		theGraph := graph.NewGraph(network.Name, cfg.GetSubgraphPath(), cfg.GetGraphNodeURL(), log)
-		prod := producer.NewProducer(client, log, network.Name)
-		detect := explorer.NewTokenDetector(clients, log)
-
-		app := application.NewSupervisor(
-			detect,
-			prod,
-			repo,
-			theGraph,
-			log,
-			entity.Atoi[network.Name],
-		)
-
-		closer.AddCloser(app.Stop, network.Name)
-
-		//go app.Spin()
+	}
+	// the detectors share clients, so no supervisor spins before it is complete
+	for _, network := range cfg.Networks {
+		networks[network.Name] = assemble(ctx, cfg, network, clients[network.Name], clients, sources, pgConnector, log).supervisor
+	}
+	closer.AddCloser(networks.Stop, "networks")
+	for _, network := range cfg.Networks {
+		networks[network.Name].Spin()
 	}
 
-	detect := explorer.NewTokenDetector(clients, log)
+	detect := explorer.NewTokenDetector(clients, sources, log)
 	theGraph := graph.NewGraph("universal", cfg.GetSubgraphPath(), cfg.GetGraphNodeURL(), log)
 	repo := storage.NewStorage(ctx, pgConnector, log)
-	server := grpc.InitForgeGRPC(log, theGraph, detect, repo)
+	managed := make(map[string]interfaces.Network, len(networks))
+	for name, network := range networks {
+		managed[name] = network
+	}
+	server := grpc.InitForgeGRPC(log, theGraph, detect, repo, managed)
 
 	closer.AddCloser(server.GracefulStop, "grpc")
 
@@ -103,10 +196,19 @@ func main() {
 		}
 	}()
 
-	<-ctx.Done()
+	metrics := &http.Server{Addr: fmt.Sprintf(":%d", cfg.GetMetricsPort()), Handler: promhttp.Handler()}
+	closer.AddCloser(func() { _ = metrics.Close() }, "metrics")
+
 	go func() {
-		closer.CloseAll()
+		log.Info(fmt.Sprintf("metrics are served on %s", metrics.Addr))
+		if err := metrics.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("failed to serve metrics:", zap.Error(err))
+		}
 	}()
+
+	<-ctx.Done()
 	stop()
+	closer.CloseAll()
+	log.Info("networks stopped", zap.Any("states", networks.States()))
 	os.Exit(0)
 }
@@ -23,6 +23,9 @@ const (
 	SubgraphService_CreateSubgraph_FullMethodName      = "/proto.SubgraphService/CreateSubgraph"
 	SubgraphService_DeleteSubgraph_FullMethodName      = "/proto.SubgraphService/DeleteSubgraph"
 	SubgraphService_CreateSubgraphBatch_FullMethodName = "/proto.SubgraphService/CreateSubgraphBatch"
+	SubgraphService_ListExceptions_FullMethodName      = "/proto.SubgraphService/ListExceptions"
+	SubgraphService_ClearExceptions_FullMethodName     = "/proto.SubgraphService/ClearExceptions"
+	SubgraphService_ListNetworks_FullMethodName        = "/proto.SubgraphService/ListNetworks"
 )
 
 // SubgraphServiceClient is the client API for SubgraphService service.
@@ -32,6 +35,9 @@ type SubgraphServiceClient interface {
 	CreateSubgraph(ctx context.Context, in *CreateSubgraphRequest, opts ...grpc.CallOption) (*CreateSubgraphResponse, error)
 	DeleteSubgraph(ctx context.Context, in *DeleteSubgraphRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreateSubgraphBatch(ctx context.Context, in *CreateSubgraphBatchRequest, opts ...grpc.CallOption) (*CreateSubgraphBatchResponse, error)
+	ListExceptions(ctx context.Context, in *ListExceptionsRequest, opts ...grpc.CallOption) (*ListExceptionsResponse, error)
+	ClearExceptions(ctx context.Context, in *ClearExceptionsRequest, opts ...grpc.CallOption) (*ClearExceptionsResponse, error)
+	ListNetworks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListNetworksResponse, error)
 }
 
 type subgraphServiceClient struct {
@@ -72,6 +78,36 @@ func (c *subgraphServiceClient) CreateSubgraphBatch(ctx context.Context, in *Cre
 	return out, nil
 }
 
+func (c *subgraphServiceClient) ListExceptions(ctx context.Context, in *ListExceptionsRequest, opts ...grpc.CallOption) (*ListExceptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExceptionsResponse)
+	err := c.cc.Invoke(ctx, SubgraphService_ListExceptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subgraphServiceClient) ClearExceptions(ctx context.Context, in *ClearExceptionsRequest, opts ...grpc.CallOption) (*ClearExceptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearExceptionsResponse)
+	err := c.cc.Invoke(ctx, SubgraphService_ClearExceptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subgraphServiceClient) ListNetworks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListNetworksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNetworksResponse)
+	err := c.cc.Invoke(ctx, SubgraphService_ListNetworks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubgraphServiceServer is the server API for SubgraphService service.
 // All implementations must embed UnimplementedSubgraphServiceServer
 // for forward compatibility.
@@ -79,6 +115,9 @@ type SubgraphServiceServer interface {
 	CreateSubgraph(context.Context, *CreateSubgraphRequest) (*CreateSubgraphResponse, error)
 	DeleteSubgraph(context.Context, *DeleteSubgraphRequest) (*emptypb.Empty, error)
 	CreateSubgraphBatch(context.Context, *CreateSubgraphBatchRequest) (*CreateSubgraphBatchResponse, error)
+	ListExceptions(context.Context, *ListExceptionsRequest) (*ListExceptionsResponse, error)
+	ClearExceptions(context.Context, *ClearExceptionsRequest) (*ClearExceptionsResponse, error)
+	ListNetworks(context.Context, *emptypb.Empty) (*ListNetworksResponse, error)
 	mustEmbedUnimplementedSubgraphServiceServer()
 }
 
@@ -98,6 +137,15 @@ func (UnimplementedSubgraphServiceServer) DeleteSubgraph(context.Context, *Delet
 func (UnimplementedSubgraphServiceServer) CreateSubgraphBatch(context.Context, *CreateSubgraphBatchRequest) (*CreateSubgraphBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSubgraphBatch not implemented")
 }
+func (UnimplementedSubgraphServiceServer) ListExceptions(context.Context, *ListExceptionsRequest) (*ListExceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExceptions not implemented")
+}
+func (UnimplementedSubgraphServiceServer) ClearExceptions(context.Context, *ClearExceptionsRequest) (*ClearExceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearExceptions not implemented")
+}
+func (UnimplementedSubgraphServiceServer) ListNetworks(context.Context, *emptypb.Empty) (*ListNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNetworks not implemented")
+}
 func (UnimplementedSubgraphServiceServer) mustEmbedUnimplementedSubgraphServiceServer() {}
 func (UnimplementedSubgraphServiceServer) testEmbeddedByValue()                         {}
 
@@ -173,6 +221,60 @@ func _SubgraphService_CreateSubgraphBatch_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubgraphService_ListExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubgraphServiceServer).ListExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubgraphService_ListExceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubgraphServiceServer).ListExceptions(ctx, req.(*ListExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubgraphService_ClearExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubgraphServiceServer).ClearExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubgraphService_ClearExceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubgraphServiceServer).ClearExceptions(ctx, req.(*ClearExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubgraphService_ListNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubgraphServiceServer).ListNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubgraphService_ListNetworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubgraphServiceServer).ListNetworks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SubgraphService_ServiceDesc is the grpc.ServiceDesc for SubgraphService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +294,18 @@ var SubgraphService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSubgraphBatch",
 			Handler:    _SubgraphService_CreateSubgraphBatch_Handler,
 		},
+		{
+			MethodName: "ListExceptions",
+			Handler:    _SubgraphService_ListExceptions_Handler,
+		},
+		{
+			MethodName: "ClearExceptions",
+			Handler:    _SubgraphService_ClearExceptions_Handler,
+		},
+		{
+			MethodName: "ListNetworks",
+			Handler:    _SubgraphService_ListNetworks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "forge.proto",
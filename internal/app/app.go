@@ -4,7 +4,10 @@ import (
 	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
 	i "git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
 	"go.uber.org/zap"
+	"math/big"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 type Supervisor struct {
@@ -13,39 +16,120 @@ type Supervisor struct {
 	storage  i.Storage
 	graph    i.Graph
 
+	backfiller   i.Backfiller
+	backfillFrom *big.Int
+
 	contracts []*ent.Contract
 	chainID   int64
 
 	usedContracts map[string]struct{}
-	newContracts  map[string]struct{}
+	// newContracts are the collected contracts by the block they were found at.
+	newContracts map[string]*big.Int
 
 	log *zap.Logger
 
+	// done is closed to stop the loops of the current run, halt closes it once.
+	done chan struct{}
+	halt func()
+	// drained is closed once the block loop of the current run has returned.
+	drained chan struct{}
+	wg      sync.WaitGroup
+	err     error
+
+	// quit is closed by Stop, finished is closed once Spin gives up or is stopped.
+	quit        chan struct{}
+	finished    chan struct{}
+	started     atomic.Bool
+	lifecycle   sync.Mutex
+	maxFailures int
+	state       atomic.Value
+	// backoff returns the delay before the n-th consecutive restart.
+	backoff func(n int) time.Duration
+
+	exceptionTTL    time.Duration
+	trackTokens     bool
+	metadataRefresh time.Duration
+
+	backfillOnce sync.Once
+
 	sync.Mutex
 }
 
 // NewSupervisor initializes a new Supervisor instance.
 // It sets up the context, producer, storage, graph, and other necessary components.
 // It also loads existing contracts from storage and prepares channels for communication.
+// If backfillFrom is not nil, the blocks from it up to the first handled block are backfilled on Spin.
+// Spin gives up after maxFailures consecutive failures, it retries forever if maxFailures is not positive.
+// Rejected contracts are skipped for exceptionTTL before they are evaluated again.
+// ERC-20 contracts are registered along with NFTs only if trackTokens is set.
+// The metadata of registered contracts is read again once it is older than metadataRefresh.
 func NewSupervisor(
 	explorer i.Detector,
 	producer i.Producer,
 	storage i.Storage,
 	graph i.Graph,
+	backfiller i.Backfiller,
 	log *zap.Logger,
 	chainId int64,
+	backfillFrom *big.Int,
+	maxFailures int,
+	exceptionTTL time.Duration,
+	trackTokens bool,
+	metadataRefresh time.Duration,
 ) *Supervisor {
-	return &Supervisor{
+	s := &Supervisor{
 		explorer: explorer,
 		producer: producer,
 		storage:  storage,
 		graph:    graph,
 
+		backfiller:   backfiller,
+		backfillFrom: backfillFrom,
+
 		chainID: chainId,
 
 		usedContracts: make(map[string]struct{}),
-		newContracts:  make(map[string]struct{}),
+		newContracts:  make(map[string]*big.Int),
+
+		quit:        make(chan struct{}),
+		finished:    make(chan struct{}),
+		maxFailures: maxFailures,
+		backoff:     backoff,
+
+		exceptionTTL:    exceptionTTL,
+		trackTokens:     trackTokens,
+		metadataRefresh: metadataRefresh,
 
 		log: log,
 	}
+	s.state.Store(ent.StateStopped)
+	reportState(ent.Itoa[chainId], ent.StateStopped)
+
+	return s
+}
+
+// State returns the current state of the supervisor: running, backing off, failed or stopped.
+func (s *Supervisor) State() ent.NetworkState {
+	return s.state.Load().(ent.NetworkState)
+}
+
+func (s *Supervisor) setState(state ent.NetworkState) {
+	reportState(ent.Itoa[s.chainID], state)
+	if old := s.state.Swap(state); old != state {
+		s.log.Info("supervisor state changed", zap.String("from", string(old.(ent.NetworkState))), zap.String("to", string(state)))
+	}
+}
+
+func (s *Supervisor) quitting() bool {
+	select {
+	case <-s.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+// TracksTokens reports whether ERC-20 contracts are registered too.
+func (s *Supervisor) TracksTokens() bool {
+	return s.trackTokens
 }
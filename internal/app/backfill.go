@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+	"math/big"
+)
+
+// Backfill scans the historical blocks [from, to] and registers the contracts found there.
+// It shares the deduplication with the live pipeline, so a contract is registered once.
+func (s *Supervisor) Backfill(ctx context.Context, from, to *big.Int) error {
+	return s.backfiller.Backfill(ctx, from, to, s.Register)
+}
+
+// Register confirms candidate contracts found outside the live pipeline and registers them
+// block by block, the candidates of a block are confirmed together. They are queued first,
+// so the ones that fail are retried by the queue worker.
+// Contracts must be ordered by the block they were found at.
+func (s *Supervisor) Register(ctx context.Context, contracts []*ent.Contract) error {
+	var batch []*ent.Contract
+	for i, contract := range contracts {
+		if s.accept(contract) {
+			batch = append(batch, contract)
+		}
+
+		if last := i == len(contracts)-1; !last && contracts[i+1].FoundAt().Cmp(contract.FoundAt()) == 0 {
+			continue
+		}
+		if len(batch) == 0 {
+			continue
+		}
+
+		if err := s.registerBlock(ctx, batch); err != nil {
+			return err
+		}
+
+		batch = nil
+	}
+
+	return nil
+}
+
+// accept reports whether the candidate is new to the supervisor and worth confirming.
+func (s *Supervisor) accept(contract *ent.Contract) bool {
+	s.Lock()
+	_, isNew := s.newContracts[contract.Address]
+	_, used := s.usedContracts[contract.Address]
+	s.Unlock()
+	if isNew || used {
+		return false
+	}
+
+	return contract.Type != ent.ERC20Type || s.trackTokens
+}
+
+func (s *Supervisor) backfill(first *big.Int) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-s.quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if stored, err := s.storage.FirstBlock(ctx, s.chainID); err != nil {
+		s.log.Error("failed to get first block", zap.Error(err))
+		return
+	} else if stored != nil && stored.Cmp(first) < 0 {
+		first = stored
+	}
+
+	to := new(big.Int).Sub(first, big.NewInt(1))
+	if to.Cmp(s.backfillFrom) < 0 {
+		return
+	}
+
+	if err := s.Backfill(ctx, s.backfillFrom, to); err != nil {
+		s.log.Error("backfill failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,22 @@
+package app
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	backoffBase = time.Second
+	backoffMax  = 5 * time.Minute
+)
+
+// backoff returns the delay before the n-th consecutive restart:
+// it doubles from backoffBase up to backoffMax and is jittered down by up to a half.
+func backoff(n int) time.Duration {
+	delay := backoffMax
+	if n < 20 {
+		delay = min(backoffBase<<max(n-1, 0), backoffMax)
+	}
+
+	return delay/2 + rand.N(delay/2+1)
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_backoff(t *testing.T) {
+	tests := []struct {
+		n        int
+		min, max time.Duration
+	}{
+		{n: 1, min: 500 * time.Millisecond, max: time.Second},
+		{n: 3, min: 2 * time.Second, max: 4 * time.Second},
+		{n: 12, min: backoffMax / 2, max: backoffMax},
+		{n: 1000, min: backoffMax / 2, max: backoffMax},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			if got := backoff(tt.n); got < tt.min || got > tt.max {
+				t.Fatalf("backoff(%d) = %v, want within [%v, %v]", tt.n, got, tt.min, tt.max)
+			}
+		}
+	}
+}
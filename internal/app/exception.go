@@ -0,0 +1,106 @@
+package app
+
+import (
+	"context"
+	"errors"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+	"time"
+)
+
+// except skips the contract until its exception expires and persists the exception so it survives restarts.
+func (s *Supervisor) except(ctx context.Context, contract *ent.Contract, reason ent.ExceptionReason) {
+	now := time.Now()
+	until := now.Add(s.exceptionTTL)
+
+	s.producer.Exception(contract.Address, until)
+
+	err := s.storage.SaveException(ctx, &ent.Exception{
+		ChainID:   s.chainID,
+		Address:   contract.Address,
+		Reason:    reason,
+		FirstSeen: contract.FoundAt(),
+		CheckedAt: now,
+		ExpiresAt: until,
+	})
+	if err != nil {
+		s.log.Error("failed to save exception", zap.String("addr", contract.Address), zap.Error(err))
+	}
+}
+
+// loadExceptions hands the persisted exceptions that have not expired yet to the producer.
+func (s *Supervisor) loadExceptions(ctx context.Context) {
+	exceptions, err := s.storage.Exceptions(ctx, s.chainID, false)
+	if err != nil {
+		s.log.Error("failed to load exceptions", zap.Error(err))
+		return
+	}
+
+	for _, e := range exceptions {
+		s.producer.Exception(e.Address, e.ExpiresAt)
+	}
+}
+
+// Exceptions returns the contracts the network skips, the expired ones only if all is set.
+func (s *Supervisor) Exceptions(ctx context.Context, all bool) ([]*ent.Exception, error) {
+	return s.storage.Exceptions(ctx, s.chainID, all)
+}
+
+// ClearExceptions stops skipping the given contracts, or every contract if all is set.
+func (s *Supervisor) ClearExceptions(ctx context.Context, addresses []string, all bool) (int64, error) {
+	cleared, err := s.storage.ClearExceptions(ctx, s.chainID, addresses, all)
+	if err != nil {
+		return 0, err
+	}
+
+	if all {
+		s.producer.ForgiveAll()
+	} else {
+		s.producer.Forgive(addresses...)
+	}
+
+	return cleared, nil
+}
+
+// exceptionReason tells whether the error of loading a contract means the contract is to be skipped.
+func exceptionReason(err error) (ent.ExceptionReason, bool) {
+	switch {
+	case errors.Is(err, ent.ErrNOTOK):
+		return ent.ReasonNoExplorerData, true
+	case errors.Is(err, ent.ErrUnknownType):
+		return ent.ReasonUnknownType, true
+	case errors.Is(err, ent.ErrNotContract):
+		return ent.ReasonNotContract, true
+	default:
+		return "", false
+	}
+}
+
+// confirm probes the candidates with the standards their transfer logs suggest in one round trip.
+// It returns the ones that implement them and the ones that could not be probed for a transient reason,
+// the others are skipped.
+func (s *Supervisor) confirm(ctx context.Context, contracts []*ent.Contract) (accepted []*ent.Contract, failed []failure) {
+	if len(contracts) == 0 {
+		return nil, nil
+	}
+
+	errs := s.explorer.Confirm(ctx, contracts)
+	for i, contract := range contracts {
+		switch {
+		case errs[i] == nil:
+			accepted = append(accepted, contract)
+			continue
+		case ent.Transient(errs[i]):
+			failed = append(failed, failure{contract: contract, err: errs[i]})
+			continue
+		}
+
+		reason := ent.ReasonNotERC721
+		if contract.Type == ent.ERC20Type {
+			reason = ent.ReasonNotERC20
+		}
+		s.except(ctx, contract, reason)
+	}
+
+	return accepted, failed
+}
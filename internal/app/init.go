@@ -2,41 +2,106 @@ package app
 
 import (
 	"context"
-	"errors"
 	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
 	"go.uber.org/zap"
 )
 
 // InitContracts initializes new contracts in the graph and saves them to storage.
-// If `init` is true, it checks if the contract already exists in the graph before initializing.
+// The collected candidates are queued first so none is lost if registering them fails,
+// then they are confirmed together and the rejected ones are skipped.
+// Candidates that fail are left in the queue for the queue worker to try again.
 // It also marks the contract as "used" after successful initialization.
 func (s *Supervisor) InitContracts(blockNumber int64) error {
 	ctx := context.Background()
+
+	registered, err := s.registerCollected(ctx, blockNumber)
+	s.complete(ctx, registered)
+
+	return err
+}
+
+// registerCollected queues the collected candidates and registers them at the forge block under the lock.
+// It returns the contracts registered.
+func (s *Supervisor) registerCollected(ctx context.Context, blockNumber int64) ([]*ent.Contract, error) {
 	s.Lock()
 	defer s.Unlock()
 
-	for _, contract := range s.contracts {
-		if err := s.explorer.LoadInfo(ctx, contract); err != nil {
-			if errors.Is(err, ent.ErrNOTOK) {
-				s.producer.Exception(contract.Address)
-				s.log.Debug("skipped due to spam", zap.String("addr", contract.Address))
+	if len(s.contracts) == 0 {
+		return nil, nil
+	}
+
+	if err := s.storage.EnqueueContracts(ctx, s.contracts, queueLease); err != nil {
+		return nil, err
+	}
+
+	registered, err := s.register(ctx, queue(s.contracts), func() (int64, error) { return blockNumber, nil })
+	if err != nil {
+		return registered, err
+	}
+
+	s.contracts = []*ent.Contract{}
+	return registered, nil
+}
+
+// complete does the work on the registered contracts that need not hold the lock,
+// it links their proxies and reads their metadata.
+func (s *Supervisor) complete(ctx context.Context, registered []*ent.Contract) {
+	for _, contract := range registered {
+		s.link(ctx, contract)
+		s.enrich(ctx, contract)
+	}
+}
+
+// initContracts registers the given contracts as deployed at the forge block,
+// their deployments are looked up together. It returns the contracts registered and the ones
+// that failed to be registered, the ones rejected for good are skipped. The caller must hold the lock.
+func (s *Supervisor) initContracts(ctx context.Context, blockNumber int64, contracts []*ent.Contract) ([]*ent.Contract, []failure) {
+	var (
+		registered []*ent.Contract
+		failed     []failure
+	)
+
+	errs := s.explorer.LoadInfos(ctx, contracts)
+	for i, contract := range contracts {
+		if err := errs[i]; err != nil {
+			// a transient failure may hide the data a permanent one lacks, so it is tried again first
+			if ent.Transient(err) {
+				s.log.Debug("failed transiently", zap.String("addr", contract.Address), zap.Error(err))
+				failed = append(failed, failure{contract: contract, err: err})
+				continue
+			}
+			if reason, ok := exceptionReason(err); ok {
+				s.except(ctx, contract, reason)
+				// an upgrade of its implementation or beacon may make the proxy a collection
+				if contract.Proxy != nil {
+					s.saveProxy(ctx, contract.Proxy)
+				}
+				s.log.Debug("skipped due to spam", zap.String("addr", contract.Address), zap.String("reason", string(reason)))
 				continue
 			}
 
-			return err
+			s.log.Error("failed to load contract", zap.String("addr", contract.Address), zap.Error(err))
+			failed = append(failed, failure{contract: contract, err: err})
+			continue
+		}
+		// a contract whose logs looked like NFT transfers may still turn out to be a token
+		if contract.Type == ent.ERC20Type && !s.trackTokens {
+			s.except(ctx, contract, ent.ReasonUntrackedERC20)
+			continue
 		}
 
 		contractID, err := s.storage.SaveContract(ctx, contract)
 		if err != nil {
-			return err
+			s.log.Error("failed to save contract", zap.String("addr", contract.Address), zap.Error(err))
+			failed = append(failed, failure{contract: contract, err: err})
+			continue
 		}
-
 		if s.storage.Initialized(ctx, contract) {
 			s.usedContracts[contract.Address] = struct{}{}
 			continue
 		}
 
-		//if err := s.graph.Init(contract.Address); err != nil {
+		//if err := s.graph.Init(contract.Address, contract.Type); err != nil {
 		//	return err
 		//}
 		//if err := s.graph.Create(contract.Address); err != nil {
@@ -47,15 +112,17 @@ func (s *Supervisor) InitContracts(blockNumber int64) error {
 		//}
 
 		if err := s.storage.SaveContractForge(ctx, blockNumber, contractID); err != nil {
-			return err
+			s.log.Error("failed to save contract forge", zap.String("addr", contract.Address), zap.Error(err))
+			failed = append(failed, failure{contract: contract, err: err})
+			continue
 		}
 
 		s.usedContracts[contract.Address] = struct{}{}
 		delete(s.newContracts, contract.Address)
+		registered = append(registered, contract)
 
 		s.log.Info("Deployed contract", zap.String("address", contract.Address))
 	}
 
-	s.contracts = []*ent.Contract{}
-	return nil
+	return registered, failed
 }
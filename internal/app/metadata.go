@@ -0,0 +1,100 @@
+package app
+
+import (
+	"context"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"git.web3gate.ru/web3/nft/GraphForge/pkg/limiter"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	// metadataInterval is how often outdated metadata is looked for, metadataBatch caps how much is read at once.
+	metadataInterval = 10 * time.Minute
+	metadataBatch    = 100
+)
+
+// enrich reads and stores the metadata of a saved contract and reports whether it succeeded.
+// A contract whose metadata cannot be read is still registered, the refresh loop tries again.
+func (s *Supervisor) enrich(ctx context.Context, contract *ent.Contract) bool {
+	m, err := s.explorer.Metadata(ctx, contract)
+	if err != nil {
+		s.log.Warn("failed to read metadata", zap.String("addr", contract.Address), zap.Error(err))
+		return false
+	}
+
+	if err := s.storage.SaveMetadata(ctx, m); err != nil {
+		s.log.Error("failed to save metadata", zap.String("addr", contract.Address), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// refreshMetadata reads again the metadata older than metadataRefresh until the run is stopped.
+// It yields to the live pipeline since nothing waits for it.
+func (s *Supervisor) refreshMetadata() {
+	if s.metadataRefresh <= 0 {
+		return
+	}
+
+	ctx := limiter.WithPriority(context.Background(), limiter.Backfill)
+	ticker := time.NewTicker(metadataInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+
+		s.refreshStale(ctx)
+	}
+}
+
+// refreshStale reads again the metadata outdated as of now batch by batch until none is left or the run is stopped.
+// A contract whose metadata cannot be read stays stale and is tried once per call,
+// the next batches are widened by the failed ones so they do not hide the rest.
+func (s *Supervisor) refreshStale(ctx context.Context) {
+	before := time.Now().Add(-s.metadataRefresh)
+	failed := make(map[string]struct{})
+	refreshed := 0
+	defer func() {
+		if refreshed > 0 || len(failed) > 0 {
+			s.log.Debug("metadata refreshed", zap.Int("contracts", refreshed), zap.Int("failed", len(failed)))
+		}
+	}()
+
+	for {
+		limit := metadataBatch + len(failed)
+		contracts, err := s.storage.StaleMetadata(ctx, s.chainID, before, limit)
+		if err != nil {
+			s.log.Error("failed to get stale metadata", zap.Error(err))
+			return
+		}
+
+		fresh := 0
+		for _, contract := range contracts {
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+
+			if _, ok := failed[contract.Address]; ok {
+				continue
+			}
+			fresh++
+
+			if s.enrich(ctx, contract) {
+				refreshed++
+			} else {
+				failed[contract.Address] = struct{}{}
+			}
+		}
+
+		if len(contracts) < limit || fresh == 0 {
+			return
+		}
+	}
+}
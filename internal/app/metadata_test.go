@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	i "git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
+	"go.uber.org/zap"
+	"slices"
+	"testing"
+	"time"
+)
+
+// staleStorage keeps the addresses of the stale contracts, the longest outdated first.
+type staleStorage struct {
+	i.Storage
+
+	stale []string
+	reads int
+}
+
+func (f *staleStorage) StaleMetadata(_ context.Context, _ int64, _ time.Time, limit int) ([]*ent.Contract, error) {
+	f.reads++
+
+	var contracts []*ent.Contract
+	for _, address := range f.stale[:min(limit, len(f.stale))] {
+		contracts = append(contracts, &ent.Contract{Address: address})
+	}
+	return contracts, nil
+}
+
+func (f *staleStorage) SaveMetadata(_ context.Context, m *ent.Metadata) error {
+	f.stale = slices.DeleteFunc(f.stale, func(address string) bool { return address == m.Address })
+	return nil
+}
+
+// unreadable fails to read the metadata of the contracts in it.
+type unreadable struct {
+	i.Detector
+
+	contracts map[string]bool
+}
+
+func (d unreadable) Metadata(_ context.Context, contract *ent.Contract) (*ent.Metadata, error) {
+	if d.contracts[contract.Address] {
+		return nil, errors.New("no metadata")
+	}
+	return &ent.Metadata{Address: contract.Address}, nil
+}
+
+func TestSupervisor_refreshStale(t *testing.T) {
+	stale := func(n int) []string {
+		addresses := make([]string, n)
+		for j := range addresses {
+			addresses[j] = fmt.Sprintf("0x%d", j)
+		}
+		return addresses
+	}
+
+	tests := []struct {
+		name       string
+		stale      int
+		unreadable int
+		left       int
+		reads      int
+	}{
+		{name: "single batch", stale: 10, left: 0, reads: 1},
+		{name: "several batches", stale: 2*metadataBatch + 50, left: 0, reads: 3},
+		{name: "full batch of unreadable", stale: metadataBatch + 10, unreadable: metadataBatch, left: metadataBatch, reads: 2},
+		{name: "only unreadable", stale: metadataBatch, unreadable: metadataBatch, left: metadataBatch, reads: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &staleStorage{stale: stale(tt.stale)}
+			explorer := unreadable{contracts: make(map[string]bool)}
+			for _, address := range storage.stale[:tt.unreadable] {
+				explorer.contracts[address] = true
+			}
+
+			s := &Supervisor{explorer: explorer, storage: storage, log: zap.NewNop(), metadataRefresh: time.Hour, done: make(chan struct{})}
+			s.refreshStale(context.Background())
+
+			if len(storage.stale) != tt.left {
+				t.Errorf("stale = %d, want %d", len(storage.stale), tt.left)
+			}
+			if storage.reads != tt.reads {
+				t.Errorf("reads = %d, want %d", storage.reads, tt.reads)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var networkState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "bcmon",
+	Subsystem: "network",
+	Name:      "state",
+	Help:      "State of the network supervisor, 1 for the current state and 0 for the others.",
+}, []string{"network", "state"})
+
+// reportState sets the state gauge of the network to the given state.
+func reportState(network string, state ent.NetworkState) {
+	for _, s := range []ent.NetworkState{ent.StateRunning, ent.StateBackingOff, ent.StateFailed, ent.StateStopped} {
+		value := 0.0
+		if s == state {
+			value = 1
+		}
+		networkState.WithLabelValues(network, string(s)).Set(value)
+	}
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"sync"
+)
+
+// Networks holds the supervisor of every indexed network by its name.
+type Networks map[string]*Supervisor
+
+// States returns the current state of every network.
+func (n Networks) States() map[string]ent.NetworkState {
+	states := make(map[string]ent.NetworkState, len(n))
+	for name, s := range n {
+		states[name] = s.State()
+	}
+	return states
+}
+
+// Stop stops every network at once, so shutdown takes as long as the slowest network
+// and never longer than stopTimeout.
+func (n Networks) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, s := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.stop(ctx)
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+)
+
+// link records the implementation behind the contract if it is a proxy. The proxy the contract was
+// resolved as while its type was detected is reused, otherwise it is resolved here.
+// A contract that cannot be resolved is still registered, it is resolved again on its next upgrade.
+func (s *Supervisor) link(ctx context.Context, contract *ent.Contract) {
+	proxy := contract.Proxy
+	if proxy == nil {
+		var err error
+		if proxy, err = s.explorer.ResolveProxy(ctx, contract); err != nil {
+			s.log.Warn("failed to resolve proxy", zap.String("addr", contract.Address), zap.Error(err))
+			return
+		}
+		if proxy == nil {
+			return
+		}
+	}
+
+	s.saveProxy(ctx, proxy)
+}
+
+func (s *Supervisor) saveProxy(ctx context.Context, proxy *ent.Proxy) {
+	if err := s.storage.SaveProxy(ctx, proxy); err != nil {
+		s.log.Error("failed to save proxy", zap.String("addr", proxy.Address), zap.Error(err))
+		return
+	}
+
+	s.log.Debug("proxy linked", zap.String("addr", proxy.Address), zap.String("impl", proxy.Implementation), zap.String("kind", string(proxy.Kind)))
+}
+
+// upgrade resolves again the proxies an Upgraded or BeaconUpgraded log concerns: the proxy that emitted it,
+// or the proxies behind the beacon that did. Upgrades of contracts the network neither tracks nor skips are ignored.
+// A skipped proxy is forgiven, its new implementation may make it a collection.
+func (s *Supervisor) upgrade(ctx context.Context, u *ent.Upgrade) {
+	proxies, err := s.storage.Proxies(ctx, s.chainID, u.Address)
+	if err != nil {
+		s.log.Error("failed to get proxies", zap.String("addr", u.Address), zap.Error(err))
+		return
+	}
+
+	var emitter bool
+	affected := proxies[:0]
+	for _, proxy := range proxies {
+		// a proxy switching beacons leaves the proxies behind its old beacon alone
+		if u.Beacon && proxy.Address != u.Address {
+			continue
+		}
+		emitter = emitter || proxy.Address == u.Address
+		affected = append(affected, proxy)
+	}
+
+	s.Lock()
+	_, used := s.usedContracts[u.Address]
+	s.Unlock()
+
+	if !emitter && (used || s.producer.Excepted(u.Address)) {
+		// the contract was registered or skipped before it was resolved, or before it became a proxy
+		affected = append(affected, &ent.Proxy{Address: u.Address})
+	}
+
+	var excepted []string
+	for _, proxy := range affected {
+		s.link(ctx, &ent.Contract{Network: ent.Itoa[s.chainID], ChainID: s.chainID, Address: proxy.Address})
+		s.log.Info("proxy upgraded", zap.String("addr", proxy.Address), zap.String("by", u.Address), zap.Int64("block", u.Block.Int64()))
+
+		if s.producer.Excepted(proxy.Address) {
+			excepted = append(excepted, proxy.Address)
+		}
+	}
+	if len(excepted) == 0 {
+		return
+	}
+
+	if _, err := s.storage.ClearExceptions(ctx, s.chainID, excepted, false); err != nil {
+		s.log.Error("failed to clear exceptions", zap.Strings("addrs", excepted), zap.Error(err))
+		return
+	}
+	s.producer.Forgive(excepted...)
+}
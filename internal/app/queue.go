@@ -0,0 +1,199 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"git.web3gate.ru/web3/nft/GraphForge/pkg/limiter"
+	"go.uber.org/zap"
+	"slices"
+	"time"
+)
+
+const (
+	// queueInterval is how often the queue is looked for contracts due again, queueBatch caps how many are taken at once.
+	queueInterval = 30 * time.Second
+	queueBatch    = 100
+
+	// queueLease is how long queued contracts are left to whoever enqueued or claimed them
+	// before the worker may claim them again.
+	queueLease = 15 * time.Minute
+	// maxQueueAttempts is how many failed attempts a queued contract gets before it is dead-lettered.
+	maxQueueAttempts = 20
+
+	// retryBase doubles with every failed attempt to register a queued contract up to retryMax.
+	retryBase = time.Minute
+	retryMax  = time.Hour
+)
+
+// failure is a contract that failed to be registered and the error it failed with.
+type failure struct {
+	contract *ent.Contract
+	err      error
+}
+
+// register confirms the queued contracts and registers the confirmed ones as deployed at the forge block,
+// forge is asked for the block only if any is confirmed. The contracts registered or rejected are taken
+// off the queue and the failed ones are put off for a backoff growing with their attempts.
+// Contracts registered meanwhile by another path are only taken off the queue, and so are ERC-20 contracts
+// unless tokens are tracked, they are skipped.
+// It returns the contracts registered. The caller must hold the lock.
+func (s *Supervisor) register(ctx context.Context, queued []*ent.QueuedContract, forge func() (int64, error)) ([]*ent.Contract, error) {
+	var contracts []*ent.Contract
+	for _, q := range queued {
+		if _, used := s.usedContracts[q.Contract.Address]; used {
+			continue
+		}
+		// tokens are told by the shape of their transfer logs, so untracked ones cost no lookups
+		if q.Contract.Type == ent.ERC20Type && !s.trackTokens {
+			s.except(ctx, q.Contract, ent.ReasonUntrackedERC20)
+			continue
+		}
+		contracts = append(contracts, q.Contract)
+	}
+
+	var registered []*ent.Contract
+	accepted, failed := s.confirm(ctx, contracts)
+	if len(accepted) > 0 {
+		blockID, err := forge()
+		if err != nil {
+			for _, contract := range accepted {
+				failed = append(failed, failure{contract: contract, err: err})
+			}
+		} else {
+			var initFailed []failure
+			registered, initFailed = s.initContracts(ctx, blockID, accepted)
+			failed = append(failed, initFailed...)
+		}
+	}
+
+	return registered, s.settle(ctx, queued, failed)
+}
+
+// settle takes the queued contracts that did not fail off the queue and reschedules the failed ones.
+// The caller must hold the lock.
+func (s *Supervisor) settle(ctx context.Context, queued []*ent.QueuedContract, failed []failure) error {
+	errs := make(map[*ent.Contract]error, len(failed))
+	for _, f := range failed {
+		errs[f.contract] = f.err
+	}
+
+	now := time.Now()
+	var done []string
+	for _, q := range queued {
+		cause, ok := errs[q.Contract]
+		if !ok {
+			done = append(done, q.Contract.Address)
+			delete(s.newContracts, q.Contract.Address)
+			continue
+		}
+
+		dead, err := s.storage.RescheduleContract(ctx, s.chainID, q.Contract.Address, cause.Error(), now.Add(retryDelay(q.Attempts+1)), maxQueueAttempts)
+		if err != nil {
+			return err
+		}
+		if dead {
+			delete(s.newContracts, q.Contract.Address)
+			s.log.Error("contract dead-lettered", zap.String("addr", q.Contract.Address), zap.Int("attempts", maxQueueAttempts), zap.Error(cause))
+		}
+	}
+	if len(failed) > 0 {
+		s.log.Warn("contracts failed to register, they stay queued", zap.Int("contracts", len(failed)))
+	}
+
+	if len(done) == 0 {
+		return nil
+	}
+	return s.storage.DequeueContracts(ctx, s.chainID, done)
+}
+
+// registerBlock queues the candidates found at the same block and registers them there.
+func (s *Supervisor) registerBlock(ctx context.Context, contracts []*ent.Contract) error {
+	if err := s.storage.EnqueueContracts(ctx, contracts, queueLease); err != nil {
+		return err
+	}
+	return s.registerQueued(ctx, queue(contracts))
+}
+
+// registerQueued registers the queued contracts found at the same block there.
+func (s *Supervisor) registerQueued(ctx context.Context, queued []*ent.QueuedContract) error {
+	number := queued[0].Contract.FoundAt()
+
+	s.Lock()
+	registered, err := s.register(ctx, queued, func() (int64, error) {
+		return s.storage.SaveBlock(ctx, &ent.Block{Number: number}, s.chainID)
+	})
+	s.Unlock()
+
+	s.complete(ctx, registered)
+	return err
+}
+
+// drainQueue claims the queued contracts that are due again and registers them until the run is stopped.
+// The contracts a previous run left queued are picked up here, so nothing discovered is lost on restart.
+// Contracts the block loop or the backfill are registering are leased to them and not claimed.
+func (s *Supervisor) drainQueue() {
+	ctx := limiter.WithPriority(context.Background(), limiter.Backfill)
+	ticker := time.NewTicker(queueInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+
+		queued, err := s.storage.ClaimContracts(ctx, s.chainID, queueBatch, queueLease)
+		if err != nil {
+			s.log.Error("failed to claim queued contracts", zap.Error(err))
+			continue
+		}
+
+		for _, batch := range byBlock(queued) {
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+
+			if err := s.registerQueued(ctx, batch); err != nil {
+				s.log.Error("failed to register queued contracts", zap.Error(err))
+			}
+		}
+		if len(queued) > 0 {
+			s.log.Debug("queue drained", zap.Int("contracts", len(queued)))
+		}
+	}
+}
+
+// queue wraps contracts that have not been attempted yet.
+func queue(contracts []*ent.Contract) []*ent.QueuedContract {
+	queued := make([]*ent.QueuedContract, len(contracts))
+	for i, contract := range contracts {
+		queued[i] = &ent.QueuedContract{Contract: contract}
+	}
+	return queued
+}
+
+// byBlock groups the queued contracts by the block they were found at, in block order.
+func byBlock(queued []*ent.QueuedContract) [][]*ent.QueuedContract {
+	sorted := slices.SortedStableFunc(slices.Values(queued), func(a, b *ent.QueuedContract) int {
+		return cmp.Compare(a.Contract.FoundAt().Int64(), b.Contract.FoundAt().Int64())
+	})
+
+	var groups [][]*ent.QueuedContract
+	for i, q := range sorted {
+		if i > 0 && q.Contract.FoundAt().Cmp(sorted[i-1].Contract.FoundAt()) == 0 {
+			groups[len(groups)-1] = append(groups[len(groups)-1], q)
+			continue
+		}
+		groups = append(groups, []*ent.QueuedContract{q})
+	}
+	return groups
+}
+
+// retryDelay returns how long a contract is put off after its n-th failed attempt.
+func retryDelay(n int) time.Duration {
+	return min(retryBase<<min(max(n-1, 0), 10), retryMax)
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func Test_retryDelay(t *testing.T) {
+	tests := []struct {
+		n    int
+		want time.Duration
+	}{
+		{n: 1, want: time.Minute},
+		{n: 3, want: 4 * time.Minute},
+		{n: 7, want: retryMax},
+		{n: 1000, want: retryMax},
+	}
+	for _, tt := range tests {
+		if got := retryDelay(tt.n); got != tt.want {
+			t.Errorf("retryDelay(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func Test_byBlock(t *testing.T) {
+	found := func(address string, block int64) *ent.QueuedContract {
+		c := &ent.Contract{Address: address}
+		c.Found(big.NewInt(block))
+		return &ent.QueuedContract{Contract: c}
+	}
+
+	groups := byBlock([]*ent.QueuedContract{found("a", 7), found("b", 3), found("c", 7), found("d", 5)})
+
+	want := [][]string{{"b"}, {"d"}, {"a", "c"}}
+	if len(groups) != len(want) {
+		t.Fatalf("byBlock() = %d groups, want %d", len(groups), len(want))
+	}
+	for i, group := range groups {
+		if len(group) != len(want[i]) {
+			t.Fatalf("group %d has %d contracts, want %d", i, len(group), len(want[i]))
+		}
+		for j, q := range group {
+			if q.Contract.Address != want[i][j] {
+				t.Errorf("group %d contract %d = %s, want %s", i, j, q.Contract.Address, want[i][j])
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+	"math/big"
+	"slices"
+)
+
+// Rollback unwinds blocks orphaned by a chain reorganisation.
+// Contracts registered or collected from the abandoned fork are forgotten, so they are
+// registered again once they show up on the canonical chain.
+func (s *Supervisor) Rollback(ctx context.Context, reorg *ent.Reorg) error {
+	s.Lock()
+	defer s.Unlock()
+
+	addresses, err := s.storage.Rollback(ctx, s.chainID, reorg.From)
+	if err != nil {
+		return fmt.Errorf("failed to rollback from %s: %w", reorg.From, err)
+	}
+
+	for _, address := range addresses {
+		delete(s.usedContracts, address)
+	}
+	s.discard(reorg.From)
+
+	s.log.Warn("rolled back orphaned blocks",
+		zap.Int64("from", reorg.From.Int64()),
+		zap.Int64("depth", reorg.Depth),
+		zap.Int("contracts", len(addresses)),
+	)
+
+	return nil
+}
+
+// discard drops the contracts collected from the block from on, they were found on an abandoned fork.
+// The caller must hold the lock.
+func (s *Supervisor) discard(from *big.Int) {
+	s.contracts = slices.DeleteFunc(s.contracts, func(contract *ent.Contract) bool {
+		return contract.FoundAt() != nil && contract.FoundAt().Cmp(from) >= 0
+	})
+
+	for address, foundAt := range s.newContracts {
+		if foundAt != nil && foundAt.Cmp(from) >= 0 {
+			delete(s.newContracts, address)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package app
+
+import (
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"math/big"
+	"testing"
+)
+
+func TestSupervisor_discard(t *testing.T) {
+	found := func(address string, block int64) *ent.Contract {
+		c := &ent.Contract{Address: address}
+		c.Found(big.NewInt(block))
+		return c
+	}
+
+	s := &Supervisor{newContracts: make(map[string]*big.Int)}
+	for _, c := range []*ent.Contract{found("kept", 9), found("orphaned", 10), found("later", 12)} {
+		s.contracts = append(s.contracts, c)
+		s.newContracts[c.Address] = c.FoundAt()
+	}
+
+	s.discard(big.NewInt(10))
+
+	if len(s.contracts) != 1 || s.contracts[0].Address != "kept" {
+		t.Errorf("contracts = %v, want only kept", s.contracts)
+	}
+	if len(s.newContracts) != 1 || s.newContracts["kept"] == nil {
+		t.Errorf("newContracts = %v, want only kept", s.newContracts)
+	}
+}
@@ -2,80 +2,245 @@ package app
 
 import (
 	"context"
+	"fmt"
 	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
 	"go.uber.org/zap"
+	"math/big"
+	"sync"
+	"time"
 )
 
-// Spin starts the main processes of the Supervisor.
-// It runs three main loops:
+const (
+	// recentBlocks is how many handled blocks are handed back to the producer on start
+	// so a reorganisation across a restart is still detected.
+	recentBlocks = 128
+
+	// healthyRun is how long a run has to last for its failure not to count as consecutive.
+	healthyRun = 10 * time.Minute
+)
+
+// Spin starts the main processes of the Supervisor in the background and keeps them running:
+// a failed pipeline is restarted from the last handled block with exponential backoff
+// until it fails maxFailures times in a row.
+// Each run consists of four loops:
 // 1. Retrieves new contracts from the producer.
-// 2. Saves new contracts to storage.
-// 3. Periodically initializes contracts in the graph.
-// This function blocks the current goroutine and should be stopped using the Stop() method.
+// 2. Saves handled blocks and initializes their contracts.
+// 3. Refreshes the outdated metadata of registered contracts.
+// 4. Retries the queued contracts that failed to be registered.
+// The loops are stopped using the Stop() method.
 func (s *Supervisor) Spin() {
-	blockNumber, err := s.storage.LastBlock(s.chainID)
+	s.started.Store(true)
+	go s.supervise()
+}
+
+func (s *Supervisor) supervise() {
+	defer close(s.finished)
+
+	failures := 0
+	for {
+		started := time.Now()
+		err := s.run()
+		if s.quitting() {
+			s.setState(ent.StateStopped)
+			return
+		}
+
+		if time.Since(started) > healthyRun {
+			failures = 0
+		}
+		failures++
+
+		if s.maxFailures > 0 && failures >= s.maxFailures {
+			s.setState(ent.StateFailed)
+			s.log.Error("supervisor gave up", zap.Int("failures", failures), zap.Error(err))
+			return
+		}
+
+		delay := s.backoff(failures)
+		s.setState(ent.StateBackingOff)
+		s.log.Warn("restarting supervisor", zap.Int("failures", failures), zap.Duration("in", delay), zap.Error(err))
+
+		select {
+		case <-s.quit:
+			s.setState(ent.StateStopped)
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// run starts the pipeline from the last handled block and waits until it stops.
+// It returns the error that stopped it, or nil if it was stopped by Stop.
+func (s *Supervisor) run() error {
+	ctx := context.Background()
+
+	safeHead, err := s.producer.SafeHead(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get safe head: %w", err)
+	}
+
+	blockNumber, err := s.storage.LastBlock(s.chainID, safeHead)
 	if err != nil {
-		s.log.Error("failed to get last block", zap.Error(err))
+		return fmt.Errorf("failed to get last block: %w", err)
+	}
+
+	known, err := s.storage.RecentBlocks(ctx, s.chainID, recentBlocks)
+	if err != nil {
+		s.log.Error("failed to get recent blocks", zap.Error(err))
+	}
+
+	s.loadExceptions(ctx)
+
+	if s.backfillFrom != nil {
+		first := new(big.Int).Set(blockNumber)
+		s.backfillOnce.Do(func() { go s.backfill(first) })
+	}
+
+	s.lifecycle.Lock()
+	if s.quitting() {
+		s.lifecycle.Unlock()
+		return nil
 	}
 
-	done, handled := make(chan struct{}), make(chan struct{})
-	blocks, contracts, errCh := s.producer.Produce(blockNumber, handled)
+	s.done, s.drained, s.err = make(chan struct{}), make(chan struct{}), nil
+	s.halt = sync.OnceFunc(func() { close(s.done) })
+
+	// handled is buffered so the last acknowledgement never blocks once the producer is stopped
+	handled := make(chan struct{}, 1)
+	stream := s.producer.Produce(blockNumber, known, handled)
+	s.lifecycle.Unlock()
+
+	s.setState(ent.StateRunning)
+	blocks, contracts, tokens, upgrades, errCh := stream.Blocks, stream.Contracts, stream.Tokens, stream.Upgrades, stream.Errors
 	handled <- struct{}{}
 
+	s.wg.Add(4)
+	go func() {
+		defer s.wg.Done()
+		s.refreshMetadata()
+	}()
+
 	go func() {
+		defer s.wg.Done()
+		s.drainQueue()
+	}()
+
+	go func() {
+		defer s.wg.Done()
 		for {
 			select {
-			case err := <-errCh:
-				s.log.Error("producer error", zap.Error(err))
-				close(done)
+			case err, ok := <-errCh:
+				if ok {
+					s.fail(fmt.Errorf("producer error: %w", err))
+				}
+				s.halt()
 				return
-			case <-done:
+			case <-s.done:
 				return
-			case contract := <-contracts:
-				if contract.Type != ent.ERC1155Type {
-					if !s.explorer.IsERC721(context.Background(), contract) {
-						s.producer.Exception(contract.Address)
-						continue
-					}
+			case token, ok := <-tokens:
+				if !ok {
+					tokens = nil
+					continue
 				}
 
-				s.Lock()
-				if _, exist := s.newContracts[contract.Address]; !exist {
-					if _, exist = s.usedContracts[contract.Address]; !exist {
-						s.newContracts[contract.Address] = struct{}{}
-						s.contracts = append(s.contracts, contract)
-						s.log.Debug("contract to initialize:", zap.String("net", contract.Network), zap.String("addr", contract.Address))
-					}
+				if !s.trackTokens {
+					// the producer skips it from now on instead of handing it over again
+					s.except(ctx, token, ent.ReasonUntrackedERC20)
+					continue
+				}
+				s.collect(token)
+			case u, ok := <-upgrades:
+				if !ok {
+					upgrades = nil
+					continue
 				}
-				s.Unlock()
+
+				s.upgrade(ctx, u)
+			case contract, ok := <-contracts:
+				if !ok {
+					return
+				}
+
+				s.collect(contract)
 			}
 		}
 	}()
 
 	go func() {
+		defer s.wg.Done()
+		defer close(s.drained)
 		for {
 			select {
-			case <-done:
+			case <-s.done:
 				return
-			case block := <-blocks:
+			case reorg, ok := <-stream.Reorgs:
+				if !ok {
+					return
+				}
+
+				if err := s.Rollback(context.Background(), reorg); err != nil {
+					s.fail(err)
+					return
+				}
+
+				handled <- struct{}{}
+			case block, ok := <-blocks:
+				if !ok {
+					return
+				}
+
 				blockID, err := s.storage.SaveBlock(context.Background(), block, s.chainID)
 				if err != nil {
-					errCh <- err
-					continue
+					s.fail(err)
+					return
 				}
 
 				if err := s.InitContracts(blockID); err != nil {
-					s.log.Error("init error", zap.Error(err))
-					continue
+					s.fail(fmt.Errorf("init error: %w", err))
+					return
 				}
 
-				if err := s.storage.BlockHandled(context.Background(), block, s.chainID); err != nil {
-					errCh <- err
-					continue
+				if err := s.storage.BlockHandled(context.Background(), block.Number, s.chainID); err != nil {
+					s.fail(err)
+					return
 				}
 
 				handled <- struct{}{}
 			}
 		}
 	}()
+
+	<-s.done
+	s.wg.Wait()
+
+	s.lifecycle.Lock()
+	defer s.lifecycle.Unlock()
+	return s.err
+}
+
+// fail stops the pipeline after an error it cannot recover from, so it is restarted.
+func (s *Supervisor) fail(err error) {
+	s.log.Error("supervisor error", zap.Error(err))
+	s.lifecycle.Lock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.lifecycle.Unlock()
+
+	s.producer.Stop()
+	s.halt()
+}
+
+// collect queues the candidate to be confirmed and initialized with the next handled block.
+func (s *Supervisor) collect(contract *ent.Contract) {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, exist := s.newContracts[contract.Address]; !exist {
+		if _, exist = s.usedContracts[contract.Address]; !exist {
+			s.newContracts[contract.Address] = contract.FoundAt()
+			s.contracts = append(s.contracts, contract)
+			s.log.Debug("contract to initialize:", zap.String("net", contract.Network), zap.String("addr", contract.Address))
+		}
+	}
 }
@@ -0,0 +1,201 @@
+package app
+
+import (
+	"context"
+	"errors"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	i "git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
+	"go.uber.org/zap"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeProducer struct {
+	headErr error
+	heads   atomic.Int32
+
+	sync.Mutex
+	stream  *ent.Stream
+	stopped bool
+	streams chan *ent.Stream
+}
+
+func newFakeProducer(headErr error) *fakeProducer {
+	return &fakeProducer{headErr: headErr, streams: make(chan *ent.Stream, 1)}
+}
+
+func (p *fakeProducer) Scan(context.Context, *big.Int, *big.Int) ([]*ent.Contract, error) {
+	return nil, nil
+}
+
+func (p *fakeProducer) Produce(_ *big.Int, _ []*ent.Block, handled chan struct{}) *ent.Stream {
+	p.Lock()
+	defer p.Unlock()
+
+	p.stream = &ent.Stream{
+		Blocks:    make(chan *ent.Block),
+		Contracts: make(chan *ent.Contract),
+		Tokens:    make(chan *ent.Contract),
+		Upgrades:  make(chan *ent.Upgrade),
+		Reorgs:    make(chan *ent.Reorg),
+		Errors:    make(chan error),
+	}
+	p.stopped = false
+	go func() {
+		for range handled {
+		}
+	}()
+	p.streams <- p.stream
+
+	return p.stream
+}
+
+func (p *fakeProducer) SafeHead(context.Context) (*big.Int, error) {
+	p.heads.Add(1)
+	if p.headErr != nil {
+		return nil, p.headErr
+	}
+	return big.NewInt(100), nil
+}
+
+func (p *fakeProducer) Stop() {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.stream == nil || p.stopped {
+		return
+	}
+	p.stopped = true
+	close(p.stream.Blocks)
+	close(p.stream.Contracts)
+	close(p.stream.Reorgs)
+	close(p.stream.Errors)
+}
+
+func (p *fakeProducer) Exception(string, time.Time) {}
+func (p *fakeProducer) Forgive(...string)           {}
+func (p *fakeProducer) ForgiveAll()                 {}
+func (p *fakeProducer) Excepted(string) bool        { return false }
+
+// fakeStorage implements the calls a run makes, SaveBlock blocks until release is closed.
+type fakeStorage struct {
+	i.Storage
+
+	saving  chan struct{}
+	release chan struct{}
+	handled atomic.Bool
+}
+
+func (f *fakeStorage) LastBlock(int64, *big.Int) (*big.Int, error) { return big.NewInt(90), nil }
+
+func (f *fakeStorage) RecentBlocks(context.Context, int64, int) ([]*ent.Block, error) {
+	return nil, nil
+}
+
+func (f *fakeStorage) Exceptions(context.Context, int64, bool) ([]*ent.Exception, error) {
+	return nil, nil
+}
+
+func (f *fakeStorage) SaveBlock(_ context.Context, block *ent.Block, _ int64) (int64, error) {
+	close(f.saving)
+	<-f.release
+	return block.Number.Int64(), nil
+}
+
+func (f *fakeStorage) BlockHandled(context.Context, *big.Int, int64) error {
+	f.handled.Store(true)
+	return nil
+}
+
+func newTestSupervisor(producer i.Producer, storage i.Storage, maxFailures int, delay time.Duration) *Supervisor {
+	s := NewSupervisor(nil, producer, storage, nil, nil, zap.NewNop(), 1, nil, maxFailures, time.Hour, false, 0)
+	s.backoff = func(int) time.Duration { return delay }
+	return s
+}
+
+func waitState(t *testing.T, s *Supervisor, state ent.NetworkState) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for s.State() != state {
+		if time.Now().After(deadline) {
+			t.Fatalf("state = %s, want %s", s.State(), state)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSupervisor_Spin(t *testing.T) {
+	t.Run("gives up after maxFailures", func(t *testing.T) {
+		producer := newFakeProducer(errors.New("no upstream"))
+		s := newTestSupervisor(producer, &fakeStorage{}, 3, 0)
+
+		s.Spin()
+		select {
+		case <-s.finished:
+		case <-time.After(5 * time.Second):
+			t.Fatal("supervisor did not give up")
+		}
+
+		if s.State() != ent.StateFailed {
+			t.Errorf("state = %s, want %s", s.State(), ent.StateFailed)
+		}
+		if got := producer.heads.Load(); got != 3 {
+			t.Errorf("runs = %d, want 3", got)
+		}
+	})
+
+	t.Run("stop during backoff", func(t *testing.T) {
+		s := newTestSupervisor(newFakeProducer(errors.New("no upstream")), &fakeStorage{}, 0, time.Hour)
+
+		s.Spin()
+		waitState(t, s, ent.StateBackingOff)
+
+		s.Stop()
+		if s.State() != ent.StateStopped {
+			t.Errorf("state = %s, want %s", s.State(), ent.StateStopped)
+		}
+	})
+
+	t.Run("stop waits for the block in flight", func(t *testing.T) {
+		producer := newFakeProducer(nil)
+		storage := &fakeStorage{saving: make(chan struct{}), release: make(chan struct{})}
+		s := newTestSupervisor(producer, storage, 0, time.Hour)
+
+		s.Spin()
+		stream := <-producer.streams
+		waitState(t, s, ent.StateRunning)
+
+		stream.Blocks <- &ent.Block{Number: big.NewInt(91)}
+		<-storage.saving
+
+		stopped := make(chan struct{})
+		go func() {
+			s.Stop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			t.Fatal("Stop returned before the block was handled")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(storage.release)
+		select {
+		case <-stopped:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Stop did not return")
+		}
+
+		if !storage.handled.Load() {
+			t.Error("block in flight was not marked handled")
+		}
+		if s.State() != ent.StateStopped {
+			t.Errorf("state = %s, want %s", s.State(), ent.StateStopped)
+		}
+	})
+}
@@ -1,5 +1,55 @@
 package app
 
+import (
+	"context"
+	"go.uber.org/zap"
+	"time"
+)
+
+// stopTimeout bounds how long Stop waits for the in-flight block.
+const stopTimeout = 30 * time.Second
+
+// Stop stops the producer and lets the block in flight finish InitContracts and BlockHandled,
+// then stops the remaining loops. It returns once everything has drained or stopTimeout expires.
 func (s *Supervisor) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+
+	s.stop(ctx)
+}
+
+// stop is Stop that gives up waiting once ctx is done.
+func (s *Supervisor) stop(ctx context.Context) {
+	s.lifecycle.Lock()
+	if s.quitting() {
+		s.lifecycle.Unlock()
+		return
+	}
+	close(s.quit)
+	s.producer.Stop()
+	drained, halt := s.drained, s.halt
+	s.lifecycle.Unlock()
+
+	if !s.started.Load() {
+		return
+	}
+
+	if drained != nil {
+		select {
+		case <-drained:
+		case <-ctx.Done():
+			s.log.Warn("in-flight block did not finish in time")
+		}
+	}
+
+	if halt != nil {
+		halt()
+	}
 
+	select {
+	case <-s.finished:
+		s.log.Info("supervisor stopped")
+	case <-ctx.Done():
+		s.log.Warn("supervisor did not stop in time", zap.Error(ctx.Err()))
+	}
 }
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/vault"
 	"github.com/spf13/viper"
+	"math/big"
 	"os"
+	"slices"
 	"strings"
 	"time"
 )
@@ -14,6 +16,26 @@ const (
 	stageDev   = "dev"
 	stageProd  = "prod"
 	stageLocal = "local"
+
+	defaultReorgDepth = 64
+
+	finalityLatest    = "latest"
+	finalitySafe      = "safe"
+	finalityFinalized = "finalized"
+
+	scanReceipts = "receipts"
+	scanLogs     = "logs"
+
+	defaultBackfillChunk   = 1000
+	defaultBackfillWorkers = 4
+
+	defaultMaxFailures = 10
+
+	defaultExceptionTTL = 7 * 24 * time.Hour
+
+	defaultMetadataRefresh = 24 * time.Hour
+
+	defaultMetricsPort = 2112
 )
 
 type Config struct {
@@ -29,6 +51,8 @@ type Config struct {
 
 	Debug    bool
 	GRPCPort int `mapstructure:"grpc_port" json:"grpc_port"`
+	// MetricsPort serves prometheus metrics on /metrics.
+	MetricsPort int `mapstructure:"metrics_port" json:"metrics_port"`
 
 	GraphPath    string `mapstructure:"subgraph_path" json:"subgraph_path"`
 	GraphNodeURL string `mapstructure:"graph_node_url" json:"graph_node_url"`
@@ -37,15 +61,95 @@ type Config struct {
 type Network struct {
 	Name        string `mapstructure:"name" json:"name"`
 	UpstreamURL string `mapstructure:"upstream_url" json:"upstream_url"`
+	// UpstreamURLs are the failover upstreams, they are pooled together with UpstreamURL.
+	UpstreamURLs []string `mapstructure:"upstream_urls" json:"upstream_urls"`
+	// WSURL is a websocket upstream to follow new heads, the upstream itself is used if it is a websocket.
+	WSURL string `mapstructure:"ws_url" json:"ws_url"`
+
+	// ReorgDepth is how many recent blocks are tracked to detect chain reorganisations.
+	ReorgDepth int64 `mapstructure:"reorg_depth" json:"reorg_depth"`
+
+	// Confirmations is how many blocks the producer stays behind the head.
+	// It is ignored when Finality is "safe" or "finalized".
+	Confirmations int64  `mapstructure:"confirmations" json:"confirmations"`
+	Finality      string `mapstructure:"finality" json:"finality"`
+
+	// ScanMode is either "receipts" (default) or "logs" to scan eth_getLogs over block ranges.
+	ScanMode string `mapstructure:"scan_mode" json:"scan_mode"`
+	LogRange int64  `mapstructure:"log_range" json:"log_range"`
+	// KnownAddresses bounds the cache of contracts already handed to the supervisor.
+	KnownAddresses int `mapstructure:"known_addresses" json:"known_addresses"`
+
+	// BackfillFrom enables backfilling from this block up to the first block handled live.
+	BackfillFrom    int64 `mapstructure:"backfill_from" json:"backfill_from"`
+	BackfillChunk   int64 `mapstructure:"backfill_chunk" json:"backfill_chunk"`
+	BackfillWorkers int   `mapstructure:"backfill_workers" json:"backfill_workers"`
+
+	// MaxFailures is how many consecutive failures the network survives, -1 retries forever.
+	MaxFailures int `mapstructure:"max_failures" json:"max_failures"`
+
+	// TrackERC20 registers ERC-20 contracts along with NFTs.
+	TrackERC20 bool `mapstructure:"track_erc20" json:"track_erc20"`
+
+	// ExceptionTTLHours is how long a rejected contract is skipped before it is evaluated again.
+	ExceptionTTLHours int `mapstructure:"exception_ttl_hours" json:"exception_ttl_hours"`
+
+	// MetadataRefreshHours is how old the metadata of a collection gets before it is read again.
+	MetadataRefreshHours int `mapstructure:"metadata_refresh_hours" json:"metadata_refresh_hours"`
+
+	// UpstreamRPS and UpstreamConcurrency cap the requests to each upstream, 0 is not limited.
+	UpstreamRPS         float64 `mapstructure:"upstream_rps" json:"upstream_rps"`
+	UpstreamConcurrency int     `mapstructure:"upstream_concurrency" json:"upstream_concurrency"`
+
+	// DeploymentSources are asked for the deployments of contracts in order,
+	// the deployments none of them knows are searched for on the upstreams.
+	DeploymentSources []DeploymentSource `mapstructure:"deployment_sources" json:"deployment_sources"`
 
 	//RequestDelay time.Duration `mapstructure:"request_delay" json:"request_delay"`
 	//UpdateDelay  time.Duration `mapstructure:"update_delay" json:"update_delay"`
 }
 
+// DeploymentSource is a block explorer the deployments of contracts are asked from.
+type DeploymentSource struct {
+	// Provider is etherscan, blockscout or routescan.
+	Provider string `mapstructure:"provider" json:"provider"`
+	// URL is the instance of blockscout, it overrides the API of the other providers.
+	URL string `mapstructure:"url" json:"url"`
+	// APIKeys are rotated across, APIKeysEnv names an environment variable with more of them separated by commas,
+	// so they do not have to be kept in the config file.
+	APIKeys    []string `mapstructure:"api_keys" json:"api_keys"`
+	APIKeysEnv string   `mapstructure:"api_keys_env" json:"api_keys_env"`
+	// RPS caps the requests with each key.
+	RPS float64 `mapstructure:"rps" json:"rps"`
+}
+
+// GetAPIKeys returns the keys of the config and of the environment without repeats.
+func (s *DeploymentSource) GetAPIKeys() []string {
+	keys := slices.Clone(s.APIKeys)
+	if s.APIKeysEnv != "" {
+		keys = append(keys, strings.Split(os.Getenv(s.APIKeysEnv), ",")...)
+	}
+
+	var unique []string
+	for _, key := range keys {
+		if key = strings.TrimSpace(key); key != "" && !slices.Contains(unique, key) {
+			unique = append(unique, key)
+		}
+	}
+	return unique
+}
+
 func (c *Config) GrpcPort() int {
 	return c.GRPCPort
 }
 
+func (c *Config) GetMetricsPort() int {
+	if c.MetricsPort == 0 {
+		return defaultMetricsPort
+	}
+	return c.MetricsPort
+}
+
 func (c *Config) GetGraphNodeURL() string {
 	if c.GraphNodeURL == "" {
 		panic("GraphNodeURL is not set")
@@ -53,6 +157,100 @@ func (c *Config) GetGraphNodeURL() string {
 	return c.GraphNodeURL
 }
 
+func (n *Network) GetUpstreamURLs() []string {
+	var urls []string
+	for _, url := range append([]string{n.UpstreamURL}, n.UpstreamURLs...) {
+		if url != "" && !slices.Contains(urls, url) {
+			urls = append(urls, url)
+		}
+	}
+
+	if len(urls) == 0 {
+		panic(fmt.Sprintf("no upstream is set for %s", n.Name))
+	}
+	return urls
+}
+
+func (n *Network) GetWSURL() string {
+	if n.WSURL != "" {
+		return n.WSURL
+	}
+	if strings.HasPrefix(n.UpstreamURL, "ws://") || strings.HasPrefix(n.UpstreamURL, "wss://") {
+		return n.UpstreamURL
+	}
+	return ""
+}
+
+func (n *Network) GetReorgDepth() int64 {
+	if n.ReorgDepth <= 0 {
+		return defaultReorgDepth
+	}
+	return n.ReorgDepth
+}
+
+func (n *Network) GetFinality() string {
+	switch n.Finality {
+	case "":
+		return finalityLatest
+	case finalityLatest, finalitySafe, finalityFinalized:
+		return n.Finality
+	}
+	panic(fmt.Sprintf("unknown finality %q for %s, use latest, safe or finalized", n.Finality, n.Name))
+}
+
+func (n *Network) GetScanMode() string {
+	switch n.ScanMode {
+	case "":
+		return scanReceipts
+	case scanReceipts, scanLogs:
+		return n.ScanMode
+	}
+	panic(fmt.Sprintf("unknown scan mode %q for %s, use receipts or logs", n.ScanMode, n.Name))
+}
+
+// GetBackfillFrom returns the block to backfill from or nil if backfilling is disabled.
+func (n *Network) GetBackfillFrom() *big.Int {
+	if n.BackfillFrom <= 0 {
+		return nil
+	}
+	return big.NewInt(n.BackfillFrom)
+}
+
+func (n *Network) GetBackfillChunk() int64 {
+	if n.BackfillChunk <= 0 {
+		return defaultBackfillChunk
+	}
+	return n.BackfillChunk
+}
+
+func (n *Network) GetBackfillWorkers() int {
+	if n.BackfillWorkers <= 0 {
+		return defaultBackfillWorkers
+	}
+	return n.BackfillWorkers
+}
+
+func (n *Network) GetMaxFailures() int {
+	if n.MaxFailures == 0 {
+		return defaultMaxFailures
+	}
+	return n.MaxFailures
+}
+
+func (n *Network) GetExceptionTTL() time.Duration {
+	if n.ExceptionTTLHours == 0 {
+		return defaultExceptionTTL
+	}
+	return time.Duration(n.ExceptionTTLHours) * time.Hour
+}
+
+func (n *Network) GetMetadataRefresh() time.Duration {
+	if n.MetadataRefreshHours == 0 {
+		return defaultMetadataRefresh
+	}
+	return time.Duration(n.MetadataRefreshHours) * time.Hour
+}
+
 //func (c *Network) GetRequestDelay() time.Duration {
 //	return c.RequestDelay
 //}
@@ -0,0 +1,184 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	i "git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
+	"git.web3gate.ru/web3/nft/GraphForge/pkg/limiter"
+	"go.uber.org/zap"
+	"math/big"
+	"time"
+)
+
+const (
+	scanAttempts = 3
+	retryDelay   = 5 * time.Second
+)
+
+// Engine scans historical block ranges in chunks with a bounded pool of workers.
+// Chunks are handed over in block order and persisted as done only after that,
+// so an interrupted backfill resumes from the first chunk that was not handed over.
+type Engine struct {
+	scanner i.Scanner
+	storage i.Storage
+
+	chainID   int64
+	chunkSize int64
+	workers   int
+
+	log *zap.Logger
+}
+
+type chunk struct {
+	index    int
+	from, to int64
+}
+
+type result struct {
+	chunk
+	contracts []*ent.Contract
+	err       error
+}
+
+func NewEngine(scanner i.Scanner, storage i.Storage, log *zap.Logger, chainID, chunkSize int64, workers int) *Engine {
+	return &Engine{
+		scanner:   scanner,
+		storage:   storage,
+		chainID:   chainID,
+		chunkSize: chunkSize,
+		workers:   workers,
+		log:       log,
+	}
+}
+
+// Backfill scans the blocks [from, to] and calls handle with the contracts of every chunk in block order.
+// Chunks completed by a previous run are skipped.
+func (e *Engine) Backfill(ctx context.Context, from, to *big.Int, handle func(ctx context.Context, contracts []*ent.Contract) error) error {
+	ctx = limiter.WithPriority(ctx, limiter.Backfill)
+
+	progress, err := e.storage.BackfillProgress(ctx, e.chainID, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to load backfill progress: %w", err)
+	}
+
+	var pending []chunk
+	for _, c := range plan(from.Int64(), to.Int64(), e.chunkSize) {
+		if scanned, ok := progress[c.from]; ok && scanned >= c.to {
+			continue
+		}
+		c.index = len(pending)
+		pending = append(pending, c)
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	e.log.Info("backfill started", zap.Int64("from", from.Int64()), zap.Int64("to", to.Int64()), zap.Int("chunks", len(pending)))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs, results := make(chan chunk), make(chan result)
+	// window bounds how far workers may run ahead of the chunk to be handed over next
+	window := make(chan struct{}, e.workers*2)
+
+	go func() {
+		defer close(jobs)
+		for _, c := range pending {
+			select {
+			case <-ctx.Done():
+				return
+			case window <- struct{}{}:
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- c:
+			}
+		}
+	}()
+
+	for w := 0; w < e.workers; w++ {
+		go func() {
+			for c := range jobs {
+				contracts, err := e.scan(ctx, c)
+				select {
+				case <-ctx.Done():
+					return
+				case results <- result{chunk: c, contracts: contracts, err: err}:
+				}
+			}
+		}()
+	}
+
+	done := make(map[int]result)
+	for next := 0; next < len(pending); {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case r := <-results:
+			if r.err != nil {
+				return fmt.Errorf("failed to scan blocks [%d, %d]: %w", r.from, r.to, r.err)
+			}
+			done[r.index] = r
+		}
+
+		for r, ok := done[next]; ok; r, ok = done[next] {
+			if err := handle(ctx, r.contracts); err != nil {
+				return fmt.Errorf("failed to handle blocks [%d, %d]: %w", r.from, r.to, err)
+			}
+
+			if err := e.storage.SaveBackfillChunk(ctx, e.chainID, r.from, r.to, len(r.contracts)); err != nil {
+				return fmt.Errorf("failed to save backfill progress: %w", err)
+			}
+
+			e.log.Debug("backfilled", zap.Int64("from", r.from), zap.Int64("to", r.to), zap.Int("contracts", len(r.contracts)))
+
+			delete(done, next)
+			<-window
+			next++
+		}
+	}
+
+	e.log.Info("backfill finished", zap.Int64("from", from.Int64()), zap.Int64("to", to.Int64()))
+	return nil
+}
+
+func (e *Engine) scan(ctx context.Context, c chunk) ([]*ent.Contract, error) {
+	var err error
+	for attempt := 1; attempt <= scanAttempts; attempt++ {
+		var contracts []*ent.Contract
+		contracts, err = e.scanner.Scan(ctx, big.NewInt(c.from), big.NewInt(c.to))
+		if err == nil {
+			return contracts, nil
+		}
+
+		e.log.Warn("failed to scan chunk", zap.Int64("from", c.from), zap.Int("attempt", attempt), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryDelay * time.Duration(attempt)):
+		}
+	}
+
+	return nil, err
+}
+
+// plan splits [from, to] into chunks aligned to multiples of size,
+// so the same blocks fall into the same chunks across runs.
+func plan(from, to, size int64) []chunk {
+	var chunks []chunk
+	for start := from; start <= to; {
+		end := (start/size+1)*size - 1
+		if end > to {
+			end = to
+		}
+		chunks = append(chunks, chunk{from: start, to: end})
+		start = end + 1
+	}
+	return chunks
+}
@@ -0,0 +1,43 @@
+package backfill
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_plan(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to int64
+		size     int64
+		want     []chunk
+	}{
+		{
+			name: "aligned",
+			from: 0, to: 299, size: 100,
+			want: []chunk{{from: 0, to: 99}, {from: 100, to: 199}, {from: 200, to: 299}},
+		},
+		{
+			name: "unaligned edges",
+			from: 150, to: 320, size: 100,
+			want: []chunk{{from: 150, to: 199}, {from: 200, to: 299}, {from: 300, to: 320}},
+		},
+		{
+			name: "single block",
+			from: 42, to: 42, size: 100,
+			want: []chunk{{from: 42, to: 42}},
+		},
+		{
+			name: "empty",
+			from: 10, to: 9, size: 100,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plan(tt.from, tt.to, tt.size); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("plan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// blockscoutAddress is the part of /api/v2/addresses/{address} about the creation of a contract.
+// Newer instances name the transaction creation_transaction_hash.
+type blockscoutAddress struct {
+	Creator           string `json:"creator_address_hash"`
+	CreationTx        string `json:"creation_tx_hash"`
+	CreationTxRenamed string `json:"creation_transaction_hash"`
+}
+
+type blockscoutHash struct {
+	Hash string `json:"hash"`
+}
+
+// blockscoutTransaction is the part of /api/v2/transactions/{hash} a deployment is made of.
+// Newer instances name the block block_number.
+type blockscoutTransaction struct {
+	Block           int64           `json:"block"`
+	BlockNumber     int64           `json:"block_number"`
+	Timestamp       time.Time       `json:"timestamp"`
+	From            blockscoutHash  `json:"from"`
+	To              *blockscoutHash `json:"to"`
+	CreatedContract *blockscoutHash `json:"created_contract"`
+	RawInput        string          `json:"raw_input"`
+}
+
+// blockscoutInternalTransactions is the part of /api/v2/transactions/{hash}/internal-transactions
+// that tells which contract created which.
+type blockscoutInternalTransactions struct {
+	Items []struct {
+		Type            string          `json:"type"`
+		From            blockscoutHash  `json:"from"`
+		CreatedContract *blockscoutHash `json:"created_contract"`
+	} `json:"items"`
+}
+
+// blockscout is a client of the REST API of a Blockscout instance, which serves a single chain.
+type blockscout struct {
+	url    string
+	keys   *keyring
+	client *http.Client
+	log    *zap.Logger
+}
+
+func newBlockscout(s Settings, client *http.Client, log *zap.Logger) *blockscout {
+	return &blockscout{
+		url:    strings.TrimRight(s.URL, "/"),
+		keys:   newKeyring(ProviderBlockscout+" "+s.URL, s.APIKeys, s.RPS, log),
+		client: client,
+		log:    log,
+	}
+}
+
+func (s *blockscout) Name() string {
+	return ProviderBlockscout
+}
+
+// Deployment looks up the creation transaction of the contract and then the transaction itself.
+// A contract created by another one is reported with the factory its internal transactions name,
+// the called contract may only route the call to the factory, so it is never taken for it.
+func (s *blockscout) Deployment(ctx context.Context, _ int64, address string) (*ent.Deployment, error) {
+	const op = "blockscout.Deployment"
+
+	var addr blockscoutAddress
+	if err := s.get(ctx, "/api/v2/addresses/"+address, &addr); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	txHash := addr.CreationTx
+	if txHash == "" {
+		txHash = addr.CreationTxRenamed
+	}
+	if txHash == "" {
+		return nil, fmt.Errorf("%s: %w", op, ent.ErrNOTOK)
+	}
+
+	var tx blockscoutTransaction
+	if err := s.get(ctx, "/api/v2/transactions/"+txHash, &tx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	block := tx.BlockNumber
+	if block == 0 {
+		block = tx.Block
+	}
+
+	d := &ent.Deployment{
+		ContractAddress: address,
+		ContractCreator: tx.From.Hash,
+		TxHash:          txHash,
+		BlockNumber:     strconv.FormatInt(block, 10),
+		TimeUnix:        strconv.FormatInt(tx.Timestamp.Unix(), 10),
+		Timestamp:       tx.Timestamp,
+	}
+	if tx.CreatedContract != nil && strings.EqualFold(tx.CreatedContract.Hash, address) {
+		d.CreationByteCode = tx.RawInput
+		return d, nil
+	}
+
+	var internal blockscoutInternalTransactions
+	if err := s.get(ctx, "/api/v2/transactions/"+txHash+"/internal-transactions", &internal); err != nil {
+		// the deployment is complete enough to index the contract from
+		s.log.Debug("failed to get internal transactions", zap.String("tx", txHash), zap.Error(err))
+		return d, nil
+	}
+	d.ContractFactory = internal.factory(address)
+
+	return d, nil
+}
+
+// factory returns the contract that created the address in a CREATE or CREATE2 call, empty if none did.
+func (t *blockscoutInternalTransactions) factory(address string) string {
+	for _, item := range t.Items {
+		if item.CreatedContract == nil || !strings.EqualFold(item.CreatedContract.Hash, address) {
+			continue
+		}
+		if item.Type == "create" || item.Type == "create2" {
+			return item.From.Hash
+		}
+	}
+	return ""
+}
+
+// get decodes the response of the API path into v, an unknown address or transaction is ErrNOTOK.
+// A request rejected for its key is repeated with the next key.
+func (s *blockscout) get(ctx context.Context, path string, v interface{}) error {
+	var err error
+	for range s.keys.attempts() {
+		err = s.getWith(ctx, path, v)
+		if err == nil || !(errors.Is(err, ent.ErrRateLimited) || errors.Is(err, errInvalidKey)) {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *blockscout) getWith(ctx context.Context, path string, v interface{}) (err error) {
+	key, release, err := s.keys.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+	defer func() { s.keys.report(key, err) }()
+
+	endpoint := s.url + path
+	if key.key != "" {
+		endpoint += "?" + url.Values{"apikey": {key.key}}.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request %s: %w", path, unreachable(err))
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return ent.ErrNOTOK
+	case http.StatusTooManyRequests:
+		return ent.ErrRateLimited
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return errInvalidKey
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return fmt.Errorf("%w: status %s of %s", ent.ErrUpstreamDown, resp.Status, path)
+	default:
+		return fmt.Errorf("unexpected status %s of %s", resp.Status, path)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return nil
+}
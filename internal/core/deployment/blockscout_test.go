@@ -0,0 +1,62 @@
+package deployment
+
+import (
+	"context"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBlockscout_Deployment(t *testing.T) {
+	const tx = `{"block_number":7000000,"timestamp":"2023-11-14T22:13:20Z","from":{"hash":"0xdef"},"to":{"hash":"0xrouter"},"raw_input":"0x"}`
+
+	tests := []struct {
+		name        string
+		internal    string
+		wantFactory string
+	}{
+		{
+			name:        "created by a factory",
+			internal:    `{"items":[{"type":"call","from":{"hash":"0xrouter"}},{"type":"create2","from":{"hash":"0xfactory"},"created_contract":{"hash":"0xABC"}}]}`,
+			wantFactory: "0xfactory",
+		},
+		{
+			name:     "no creation",
+			internal: `{"items":[{"type":"call","from":{"hash":"0xrouter"}}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/v2/addresses/0xabc":
+					_, _ = w.Write([]byte(`{"creation_transaction_hash":"0x01"}`))
+				case "/api/v2/transactions/0x01":
+					_, _ = w.Write([]byte(tx))
+				case "/api/v2/transactions/0x01/internal-transactions":
+					_, _ = w.Write([]byte(tt.internal))
+				default:
+					t.Errorf("unexpected path %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			source, err := New(Settings{Provider: ProviderBlockscout, URL: server.URL}, zap.NewNop())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := source.Deployment(context.Background(), ent.SEPOLIA, "0xabc")
+			if err != nil {
+				t.Fatalf("Deployment() error = %v", err)
+			}
+			// the called router is never taken for the factory
+			if got.ContractFactory != tt.wantFactory || got.BlockNumber != "7000000" {
+				t.Errorf("Deployment() = %+v, want factory %q", got, tt.wantFactory)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// etherscanV2 serves every chain of Etherscan, the chain is picked by the chainid parameter.
+	etherscanV2 = "https://api.etherscan.io/v2/api"
+	// routescanAPI is the Etherscan compatible API of Routescan, filled with the network type and the chain id.
+	routescanAPI = "https://api.routescan.io/v2/network/%s/evm/%d/etherscan/api"
+
+	noData = "No data found"
+
+	// creationBatch is how many addresses getcontractcreation takes at once.
+	creationBatch = 5
+)
+
+// etherscanResponse is the envelope of the Etherscan API, result is a message instead of data on failures.
+type etherscanResponse struct {
+	Status  string          `json:"status"`
+	Message string          `json:"message"`
+	Result  json.RawMessage `json:"result"`
+}
+
+// etherscan is a client of the Etherscan API or of an API compatible with it.
+type etherscan struct {
+	name string
+	// api returns the url of the API for the chain and the parameters that pick the chain there.
+	api    func(chainID int64) (string, url.Values)
+	keys   *keyring
+	client *http.Client
+	log    *zap.Logger
+}
+
+func newEtherscan(s Settings, client *http.Client, log *zap.Logger) *etherscan {
+	return &etherscan{
+		name: ProviderEtherscan,
+		api: func(chainID int64) (string, url.Values) {
+			base := etherscanV2
+			if s.URL != "" {
+				base = s.URL
+			}
+			return base, url.Values{"chainid": {strconv.FormatInt(chainID, 10)}}
+		},
+		keys:   newKeyring(ProviderEtherscan, s.APIKeys, s.RPS, log),
+		client: client,
+		log:    log,
+	}
+}
+
+func newRoutescan(s Settings, client *http.Client, log *zap.Logger) *etherscan {
+	return &etherscan{
+		name: ProviderRoutescan,
+		api: func(chainID int64) (string, url.Values) {
+			if s.URL != "" {
+				return s.URL, url.Values{}
+			}
+			network := "mainnet"
+			if chainID == ent.SEPOLIA || chainID == ent.HOLESKY {
+				network = "testnet"
+			}
+			return fmt.Sprintf(routescanAPI, network, chainID), url.Values{}
+		},
+		keys:   newKeyring(ProviderRoutescan, s.APIKeys, s.RPS, log),
+		client: client,
+		log:    log,
+	}
+}
+
+func (s *etherscan) Name() string {
+	return s.name
+}
+
+func (s *etherscan) Deployment(ctx context.Context, chainID int64, address string) (*ent.Deployment, error) {
+	found, err := s.Deployments(ctx, chainID, []string{address})
+	if err != nil {
+		return nil, err
+	}
+
+	d, ok := found[address]
+	if !ok {
+		return nil, fmt.Errorf("%s.Deployment: %w", s.name, ent.ErrNOTOK)
+	}
+	return d, nil
+}
+
+// Deployments looks the addresses up creationBatch at a time and matches the results back by address,
+// an address the provider does not know is left out.
+func (s *etherscan) Deployments(ctx context.Context, chainID int64, addresses []string) (map[string]*ent.Deployment, error) {
+	found := make(map[string]*ent.Deployment, len(addresses))
+
+	for start := 0; start < len(addresses); start += creationBatch {
+		batch := addresses[start:min(start+creationBatch, len(addresses))]
+
+		deployments, err := s.creations(ctx, chainID, batch)
+		if errors.Is(err, ent.ErrNOTOK) {
+			continue
+		}
+		if err != nil {
+			return found, err
+		}
+
+		for i := range deployments {
+			for _, address := range batch {
+				if strings.EqualFold(deployments[i].ContractAddress, address) {
+					found[address] = &deployments[i]
+				}
+			}
+		}
+	}
+
+	return found, nil
+}
+
+// creations calls getcontractcreation for the addresses, it fails with ErrNOTOK if none of them is known.
+// A request rejected for its key is repeated with the next key.
+func (s *etherscan) creations(ctx context.Context, chainID int64, addresses []string) ([]ent.Deployment, error) {
+	op := s.name + ".creations"
+
+	var err error
+	for range s.keys.attempts() {
+		var deployments []ent.Deployment
+		deployments, err = s.creationsWith(ctx, chainID, addresses)
+		if err == nil || !(errors.Is(err, ent.ErrRateLimited) || errors.Is(err, errInvalidKey)) {
+			return deployments, err
+		}
+	}
+
+	return nil, fmt.Errorf("%s: %w", op, err)
+}
+
+func (s *etherscan) creationsWith(ctx context.Context, chainID int64, addresses []string) (deployments []ent.Deployment, err error) {
+	op := s.name + ".creations"
+
+	key, release, err := s.keys.acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer release()
+	defer func() { s.keys.report(key, err) }()
+
+	api, query := s.api(chainID)
+	query.Set("module", "contract")
+	query.Set("action", "getcontractcreation")
+	query.Set("contractaddresses", strings.Join(addresses, ","))
+	if key.key != "" {
+		query.Set("apikey", key.key)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, api+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to request: %w", op, unreachable(err))
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return nil, fmt.Errorf("%s: %w", op, ent.ErrRateLimited)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return nil, fmt.Errorf("%s: %w: status %s", op, ent.ErrUpstreamDown, resp.Status)
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("%s: unexpected status %s", op, resp.Status)
+	}
+
+	var response etherscanResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("%s: failed to decode response: %w", op, err)
+	}
+
+	if response.Status != "1" {
+		var reason string
+		_ = json.Unmarshal(response.Result, &reason)
+		return nil, fmt.Errorf("%s: %w", op, etherscanError(response.Message, reason))
+	}
+
+	if err := json.Unmarshal(response.Result, &deployments); err != nil {
+		return nil, fmt.Errorf("%s: failed to decode result: %w", op, err)
+	}
+	if len(deployments) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, ent.ErrNOTOK)
+	}
+
+	for i := range deployments {
+		normalise(&deployments[i])
+	}
+
+	return deployments, nil
+}
+
+// etherscanError tells what a failed response means by its message and result.
+func etherscanError(message, reason string) error {
+	lower := strings.ToLower(reason)
+	switch {
+	case message == noData || reason == noData:
+		return ent.ErrNOTOK
+	case strings.Contains(lower, "rate limit"):
+		return fmt.Errorf("%w: %s", ent.ErrRateLimited, reason)
+	case strings.Contains(lower, "invalid api key"):
+		return fmt.Errorf("%w: %s", errInvalidKey, reason)
+	default:
+		return fmt.Errorf("%s: %s", message, reason)
+	}
+}
+
+// normalise fills in the timestamp of a deployment from its unix time, which some chains do not report.
+func normalise(d *ent.Deployment) {
+	if unix, err := strconv.ParseInt(d.TimeUnix, 10, 64); err == nil {
+		d.Timestamp = time.Unix(unix, 0)
+	}
+}
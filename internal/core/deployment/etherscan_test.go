@@ -0,0 +1,116 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEtherscan_Deployment(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantBlock string
+		wantErr   error
+	}{
+		{
+			name:      "found",
+			body:      `{"status":"1","message":"OK","result":[{"contractAddress":"0xabc","contractCreator":"0xdef","txHash":"0x01","blockNumber":"7000000","timestamp":"1700000000"}]}`,
+			wantBlock: "7000000",
+		},
+		{
+			name:    "no data",
+			body:    `{"status":"0","message":"No data found","result":null}`,
+			wantErr: ent.ErrNOTOK,
+		},
+		{
+			name: "rate limited",
+			body: `{"status":"0","message":"NOTOK","result":"Max rate limit reached"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("chainid") != "11155111" || r.URL.Query().Get("contractaddresses") != "0xabc" {
+					t.Errorf("unexpected query %s", r.URL.RawQuery)
+				}
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			source, err := New(Settings{Provider: ProviderEtherscan, URL: server.URL}, zap.NewNop())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := source.Deployment(context.Background(), ent.SEPOLIA, "0xabc")
+			switch {
+			case tt.wantBlock != "":
+				if err != nil {
+					t.Fatalf("Deployment() error = %v", err)
+				}
+				if got.BlockNumber != tt.wantBlock || !got.Timestamp.Equal(time.Unix(1700000000, 0)) {
+					t.Errorf("Deployment() = %+v", got)
+				}
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Deployment() error = %v, want %v", err, tt.wantErr)
+				}
+			default:
+				if err == nil || errors.Is(err, ent.ErrNOTOK) {
+					t.Errorf("Deployment() error = %v, want a failure other than no data", err)
+				}
+			}
+		})
+	}
+}
+
+func TestEtherscan_Deployments(t *testing.T) {
+	addresses := []string{"0xa1", "0xa2", "0xa3", "0xa4", "0xa5", "0xA6", "0xa7"}
+
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		batch := r.URL.Query().Get("contractaddresses")
+		requests = append(requests, batch)
+
+		switch batch {
+		case "0xa1,0xa2,0xa3,0xa4,0xa5":
+			// known in a different order, 0xa2 is unknown
+			_, _ = w.Write([]byte(`{"status":"1","message":"OK","result":[
+				{"contractAddress":"0xa5","blockNumber":"5"},{"contractAddress":"0xa1","blockNumber":"1"},
+				{"contractAddress":"0xa3","blockNumber":"3"},{"contractAddress":"0xa4","blockNumber":"4"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"1","message":"OK","result":[{"contractAddress":"0xa6","blockNumber":"6"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	source, err := New(Settings{Provider: ProviderEtherscan, URL: server.URL}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := source.(*etherscan).Deployments(context.Background(), ent.SEPOLIA, addresses)
+	if err != nil {
+		t.Fatalf("Deployments() error = %v", err)
+	}
+
+	if len(requests) != 2 || requests[1] != "0xA6,0xa7" {
+		t.Errorf("Deployments() requested %v", requests)
+	}
+
+	want := map[string]string{"0xa1": "1", "0xa3": "3", "0xa4": "4", "0xa5": "5", "0xA6": "6"}
+	if len(found) != len(want) {
+		t.Errorf("Deployments() found %d, want %d", len(found), len(want))
+	}
+	for address, block := range want {
+		if d, ok := found[address]; !ok || d.BlockNumber != block {
+			t.Errorf("Deployments()[%s] = %+v, want block %s", address, d, block)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"git.web3gate.ru/web3/nft/GraphForge/pkg/limiter"
+	"go.uber.org/zap"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// rateLimitBackoff doubles with every rate limited request of a key in a row up to maxRateLimitBackoff.
+	rateLimitBackoff    = time.Second
+	maxRateLimitBackoff = time.Minute
+	// invalidKeyBackoff is how long a rejected key is left alone, it may be fixed in the meantime.
+	invalidKeyBackoff = time.Hour
+)
+
+// errInvalidKey is a request rejected for its key, another key may be accepted.
+var errInvalidKey = errors.New("invalid api key")
+
+// apiKey is a key of a provider with its own rate limit.
+type apiKey struct {
+	key     string
+	label   string
+	limiter *limiter.Limiter
+
+	// failures is how many requests in a row were rate limited, the key is skipped until until.
+	failures int
+	until    time.Time
+}
+
+// keyring rotates the requests to a provider across its keys and backs off the keys it rejects.
+// Without keys the provider is requested without one.
+type keyring struct {
+	provider string
+	keys     []*apiKey
+	next     int
+	log      *zap.Logger
+
+	sync.Mutex
+}
+
+func newKeyring(provider string, keys []string, rps float64, log *zap.Logger) *keyring {
+	if len(keys) == 0 {
+		keys = []string{""}
+	}
+
+	r := &keyring{provider: provider, log: log}
+	for n, key := range keys {
+		label := keyLabel(n, key)
+		r.keys = append(r.keys, &apiKey{
+			key:     key,
+			label:   label,
+			limiter: limiter.New(provider+" "+label, rps, 0),
+		})
+	}
+
+	return r
+}
+
+// acquire waits for the next key that is not backed off and for its rate limit.
+func (r *keyring) acquire(ctx context.Context) (*apiKey, func(), error) {
+	for {
+		key, wait := r.pick()
+		if key != nil {
+			release, err := key.limiter.Acquire(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			return key, release, nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// pick returns the next usable key in turn, or how long to wait for the first one to be usable again.
+func (r *keyring) pick() (*apiKey, time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+
+	now := time.Now()
+	wait := time.Duration(-1)
+	for range r.keys {
+		key := r.keys[r.next]
+		r.next = (r.next + 1) % len(r.keys)
+
+		if !now.Before(key.until) {
+			return key, 0
+		}
+		if left := key.until.Sub(now); wait < 0 || left < wait {
+			wait = left
+		}
+	}
+
+	return nil, wait
+}
+
+// report records the outcome of a request made with the key and backs the key off if it was rejected.
+func (r *keyring) report(key *apiKey, err error) {
+	requests.WithLabelValues(r.provider, key.label, result(err)).Inc()
+
+	r.Lock()
+	defer r.Unlock()
+
+	switch {
+	case errors.Is(err, ent.ErrRateLimited):
+		key.failures++
+		backoff := min(rateLimitBackoff<<min(key.failures-1, 6), maxRateLimitBackoff)
+		key.until = time.Now().Add(backoff)
+		r.log.Debug("api key rate limited", zap.String("provider", r.provider), zap.String("key", key.label), zap.Duration("backoff", backoff))
+	case errors.Is(err, errInvalidKey):
+		key.until = time.Now().Add(invalidKeyBackoff)
+		r.log.Error("api key rejected", zap.String("provider", r.provider), zap.String("key", key.label), zap.Duration("backoff", invalidKeyBackoff))
+	default:
+		key.failures = 0
+	}
+}
+
+// attempts is how many times a request is tried, once with every key.
+func (r *keyring) attempts() int {
+	return len(r.keys)
+}
+
+// keyLabel tells a key apart in logs and metrics by its position in the configured keys,
+// no part of the key itself is exposed.
+func keyLabel(n int, key string) string {
+	if key == "" {
+		return "none"
+	}
+	return "key" + strconv.Itoa(n)
+}
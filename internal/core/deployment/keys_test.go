@@ -0,0 +1,73 @@
+package deployment
+
+import (
+	"context"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestKeyring_pick(t *testing.T) {
+	r := newKeyring("test", []string{"key-one", "key-two", "key-three"}, 0, zap.NewNop())
+	for n, key := range r.keys {
+		if want := "key" + strconv.Itoa(n); key.label != want {
+			t.Errorf("label = %v, want %v", key.label, want)
+		}
+	}
+
+	var got []string
+	for range 4 {
+		key, _ := r.pick()
+		got = append(got, key.key)
+	}
+	if want := []string{"key-one", "key-two", "key-three", "key-one"}; !slices.Equal(got, want) {
+		t.Errorf("pick() = %v, want %v", got, want)
+	}
+
+	r.report(r.keys[1], ent.ErrRateLimited)
+	r.report(r.keys[2], errInvalidKey)
+
+	got = got[:0]
+	for range 2 {
+		key, _ := r.pick()
+		got = append(got, key.key)
+	}
+	if want := []string{"key-one", "key-one"}; !slices.Equal(got, want) {
+		t.Errorf("pick() after backoff = %v, want %v", got, want)
+	}
+
+	r.report(r.keys[0], ent.ErrRateLimited)
+	if key, wait := r.pick(); key != nil || wait <= 0 || wait > rateLimitBackoff {
+		t.Errorf("pick() with every key backed off = %v, %v", key, wait)
+	}
+}
+
+func TestEtherscan_rotation(t *testing.T) {
+	var used []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Query().Get("apikey")
+		used = append(used, key)
+		if key == "limited-key" {
+			_, _ = w.Write([]byte(`{"status":"0","message":"NOTOK","result":"Max calls per sec rate limit reached (5/sec)"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"1","message":"OK","result":[{"contractAddress":"0xabc","blockNumber":"1"}]}`))
+	}))
+	defer server.Close()
+
+	source, err := New(Settings{Provider: ProviderEtherscan, URL: server.URL, APIKeys: []string{"limited-key", "spare-key"}}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := source.Deployment(context.Background(), 1, "0xabc"); err != nil {
+		t.Fatalf("Deployment() error = %v", err)
+	}
+	if want := []string{"limited-key", "spare-key"}; !slices.Equal(used, want) {
+		t.Errorf("Deployment() used keys %v, want %v", used, want)
+	}
+}
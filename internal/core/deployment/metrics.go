@@ -0,0 +1,31 @@
+package deployment
+
+import (
+	"errors"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var requests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "bcmon",
+	Subsystem: "deployment_source",
+	Name:      "requests_total",
+	Help:      "Requests to deployment sources by api key and result.",
+}, []string{"provider", "key", "result"})
+
+func result(err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.Is(err, ent.ErrNOTOK):
+		return "no_data"
+	case errors.Is(err, ent.ErrRateLimited):
+		return "rate_limited"
+	case errors.Is(err, errInvalidKey):
+		return "invalid_key"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,61 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	i "git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
+	"go.uber.org/zap"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	ProviderEtherscan  = "etherscan"
+	ProviderBlockscout = "blockscout"
+	ProviderRoutescan  = "routescan"
+
+	requestTimeout = 10 * time.Second
+)
+
+// Settings configure a deployment source.
+type Settings struct {
+	// Provider is etherscan, blockscout or routescan.
+	Provider string
+	// URL overrides the API of the provider, it is required for blockscout, which is hosted per chain.
+	URL string
+	// APIKeys are rotated across, each with its own rate limit. The provider is requested without a key if there are none.
+	APIKeys []string
+	// RPS caps the requests with each key, 0 is not limited.
+	RPS float64
+}
+
+// New creates the deployment source of the provider.
+func New(s Settings, log *zap.Logger) (i.DeploymentSource, error) {
+	client := &http.Client{Timeout: requestTimeout}
+
+	switch s.Provider {
+	case ProviderEtherscan:
+		return newEtherscan(s, client, log), nil
+	case ProviderRoutescan:
+		return newRoutescan(s, client, log), nil
+	case ProviderBlockscout:
+		if s.URL == "" {
+			return nil, fmt.Errorf("blockscout needs the url of its instance")
+		}
+		return newBlockscout(s, client, log), nil
+	default:
+		return nil, fmt.Errorf("unknown deployment source provider %q", s.Provider)
+	}
+}
+
+// unreachable tells why a request got no response: it timed out or the provider could not be reached.
+func unreachable(err error) error {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) && netErr.Timeout() {
+		return fmt.Errorf("%w: %w", ent.ErrTimeout, err)
+	}
+	return fmt.Errorf("%w: %w", ent.ErrUpstreamDown, err)
+}
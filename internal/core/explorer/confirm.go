@@ -0,0 +1,84 @@
+package explorer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+	"time"
+)
+
+var supportsInterfaceSelector = []byte{0x01, 0xff, 0xc9, 0xa7}
+
+// Confirm tells for each candidate whether it implements the standard its transfer logs hint at:
+// ERC-721 is confirmed by ERC-165 and ERC-20 by its view functions, ERC-1155 transfers are trusted as they are.
+// The error of a candidate is nil if it is confirmed, ErrUnsupported if it is not, or the transient failure
+// that kept it from being probed. The probes of all candidates of a network go in as few Multicall3 calls as possible.
+func (e *Explorer) Confirm(ctx context.Context, contracts []*ent.Contract) []error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	errs := make([]error, len(contracts))
+
+	networks := make(map[string][]int)
+	for i, c := range contracts {
+		if c.Type == ent.ERC1155Type {
+			continue
+		}
+		networks[c.Network] = append(networks[c.Network], i)
+	}
+
+	for network, indices := range networks {
+		var calls []call3
+		for _, i := range indices {
+			addr := common.HexToAddress(contracts[i].Address)
+			if contracts[i].Type == ent.ERC20Type {
+				probes := erc20Probes(addr)
+				calls = append(calls, probes[:]...)
+			} else {
+				calls = append(calls, call3{Target: addr, CallData: supportsInterfaceData(erc721InterfaceID)})
+			}
+		}
+
+		results, err := e.aggregate(ctx, network, calls)
+		if err != nil && !ent.Transient(err) {
+			e.log.Warn("failed to probe candidates, probing one by one", zap.String("network", network), zap.Error(err))
+			results, err = e.callEach(ctx, network, calls)
+		}
+		if err != nil {
+			for _, i := range indices {
+				errs[i] = fmt.Errorf("failed to probe %s: %w", contracts[i].Address, err)
+			}
+			continue
+		}
+
+		for _, i := range indices {
+			var confirmed bool
+			if contracts[i].Type == ent.ERC20Type {
+				confirmed = results[0].Success && results[1].Success && results[2].Success &&
+					erc20Answers(results[0].ReturnData, results[1].ReturnData, results[2].ReturnData)
+				results = results[3:]
+			} else {
+				confirmed = results[0].Success && isTrue(results[0].ReturnData)
+				results = results[1:]
+			}
+			if !confirmed {
+				errs[i] = fmt.Errorf("%s does not implement %s: %w", contracts[i].Address, contracts[i].Type, ent.ErrUnsupported)
+			}
+		}
+	}
+
+	return errs
+}
+
+// supportsInterfaceData is the calldata of the ERC-165 supportsInterface for the interface id.
+func supportsInterfaceData(interfaceID [4]byte) []byte {
+	return append(append([]byte{}, supportsInterfaceSelector...), common.RightPadBytes(interfaceID[:], 32)...)
+}
+
+// isTrue reports whether the output is an ABI-encoded true.
+func isTrue(output []byte) bool {
+	return len(output) == 32 && output[31] == 1 && bytes.Count(output[:31], []byte{0}) == 31
+}
@@ -0,0 +1,48 @@
+package explorer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+	"testing"
+)
+
+func Test_isTrue(t *testing.T) {
+	tests := []struct {
+		name   string
+		output []byte
+		want   bool
+	}{
+		{name: "true", output: common.LeftPadBytes([]byte{1}, 32), want: true},
+		{name: "false", output: make([]byte, 32), want: false},
+		{name: "garbage word", output: common.LeftPadBytes([]byte{1, 1}, 32), want: false},
+		{name: "empty", output: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTrue(tt.output); got != tt.want {
+				t.Errorf("isTrue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_erc20Answers(t *testing.T) {
+	word := func(v int64) []byte { return common.LeftPadBytes(big.NewInt(v).Bytes(), 32) }
+
+	tests := []struct {
+		name                             string
+		totalSupply, decimals, balanceOf []byte
+		want                             bool
+	}{
+		{name: "token", totalSupply: word(1e18), decimals: word(18), balanceOf: word(0), want: true},
+		{name: "huge decimals", totalSupply: word(1), decimals: word(256), balanceOf: word(0), want: false},
+		{name: "no decimals", totalSupply: word(1), decimals: nil, balanceOf: word(0), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := erc20Answers(tt.totalSupply, tt.decimals, tt.balanceOf); got != tt.want {
+				t.Errorf("erc20Answers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
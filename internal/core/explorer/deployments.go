@@ -2,107 +2,97 @@ package explorer
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	i "git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
 	"go.uber.org/zap"
-	"io"
-	"net/http"
-	"strconv"
-	"strings"
-	"time"
 )
 
-func (e *Explorer) etherscanDeploymentBatch(ctx context.Context, chainID int64, contractAddresses []string) (*[]ent.Deployment, error) {
-	const op = "app.getDeploymentBlockFromEtherscan()"
-	<-e.tokens
-
-	var baseURL, apikey string
-	switch chainID {
-	case ent.MAINNET, ent.HOLESKY, ent.SEPOLIA:
-		baseURL, apikey = ent.EtherScanKeys[chainID], e.etherScanKey
-	}
-
-	var urlBuilder strings.Builder
-	urlBuilder.Grow(170 + 42*len(contractAddresses))
-	urlBuilder.WriteString(baseURL + "?module=contract&action=getcontractcreation&contractaddresses=")
-	for _, address := range contractAddresses {
-		urlBuilder.WriteString(address)
-	}
-	urlBuilder.WriteString("&apikey=" + apikey)
-
-	resp, err := http.Get(urlBuilder.String())
-	if err != nil {
-		e.log.Error("Error making Etherscan API getContractCreation request", zap.Error(err))
-		return &[]ent.Deployment{}, fmt.Errorf("%s: %w", op, err)
-	}
-	defer resp.Body.Close()
+// lookup is the deployment of a contract or the error of looking it up.
+type lookup struct {
+	deployment *ent.Deployment
+	err        error
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		e.log.Error("Error reading Etherscan API getContractCreation response", zap.Error(err))
-		return &[]ent.Deployment{}, fmt.Errorf("%s: %w", op, err)
+// deployments asks the deployment sources of the networks of the contracts in turn, each source is asked
+// for the contracts the previous ones did not know, all at once if it allows.
+// The deployments none of them knows are searched for on the node. A contract the node cannot tell
+// the deployment of is failed transiently if a source that may know it could not be asked.
+func (e *Explorer) deployments(ctx context.Context, contracts []*ent.Contract) map[*ent.Contract]lookup {
+	found := make(map[*ent.Contract]lookup, len(contracts))
+	unasked := make(map[*ent.Contract]error)
+
+	networks := make(map[string][]*ent.Contract)
+	for _, contract := range contracts {
+		networks[contract.Network] = append(networks[contract.Network], contract)
 	}
 
-	var response ent.EtherScanResponse
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		e.log.Error("Error decoding JSON from Etherscan API getContractCreation response", zap.Error(err))
-		return &[]ent.Deployment{}, fmt.Errorf("%s: %w", op, err)
+	for network, pending := range networks {
+		for _, source := range e.sources[network] {
+			if len(pending) == 0 {
+				break
+			}
+			pending = e.ask(ctx, source, pending, found, unasked)
+		}
+
+		for _, contract := range pending {
+			d, err := e.findDeployment(ctx, contract)
+			if err != nil && !ent.Transient(err) && unasked[contract] != nil {
+				err = fmt.Errorf("%w, and a source failed: %w", err, unasked[contract])
+			}
+			found[contract] = lookup{deployment: d, err: err}
+		}
 	}
 
-	if len(response.Result) == 0 {
-		return nil, fmt.Errorf("%s: %w", op, ent.ErrNOTOK)
-	}
-
-	return &response.Result, nil
+	return found
 }
 
-func (e *Explorer) etherscanDeployment(ctx context.Context, chainID int64, contractAddress string) (*ent.Deployment, error) {
-	const op = "app.getDeploymentBlockFromEtherscan()"
-	<-e.tokens
-
-	var baseURL, apikey string
-	switch chainID {
-	case ent.MAINNET, ent.HOLESKY, ent.SEPOLIA:
-		baseURL, apikey = ent.EtherScanKeys[chainID], e.etherScanKey
-	}
-
-	resp, err := http.Get(baseURL + "?module=contract&action=getcontractcreation&contractaddresses=" + contractAddress + "&apikey=" + apikey)
-	if err != nil {
-		e.log.Error("Error making Etherscan API getContractCreation request", zap.Error(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+// ask looks the contracts up at the source and returns the ones it does not know.
+// The transient failure of asking for a contract is recorded in unasked.
+func (e *Explorer) ask(ctx context.Context, source i.DeploymentSource, contracts []*ent.Contract, found map[*ent.Contract]lookup, unasked map[*ent.Contract]error) []*ent.Contract {
+	var missing []*ent.Contract
+
+	batch, ok := source.(i.BatchDeploymentSource)
+	if !ok {
+		for _, contract := range contracts {
+			d, err := source.Deployment(ctx, contract.ChainID, contract.Address)
+			if err != nil {
+				e.log.Debug("no deployment from source", zap.String("source", source.Name()), zap.String("addr", contract.Address), zap.Error(err))
+				if ent.Transient(err) {
+					unasked[contract] = err
+				}
+				missing = append(missing, contract)
+				continue
+			}
+			found[contract] = lookup{deployment: d}
+		}
+		return missing
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		e.log.Error("Error reading Etherscan API getContractCreation response", zap.Error(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+	addresses := make([]string, len(contracts))
+	for i, contract := range contracts {
+		addresses[i] = contract.Address
 	}
 
-	var response ent.EtherScanResponse
-	err = json.Unmarshal(body, &response)
+	// contracts of one network share the chain id
+	deployments, err := batch.Deployments(ctx, contracts[0].ChainID, addresses)
 	if err != nil {
-		e.log.Error("Error decoding JSON from Etherscan API getContractCreation response", zap.Error(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		e.log.Debug("deployments from source failed", zap.String("source", source.Name()), zap.Int("found", len(deployments)), zap.Error(err))
 	}
 
-	if len(response.Result) == 0 {
-		return nil, fmt.Errorf("%s: %w", op, ent.ErrNOTOK)
+	for _, contract := range contracts {
+		if d, ok := deployments[contract.Address]; ok {
+			found[contract] = lookup{deployment: d}
+			continue
+		}
+		if ent.Transient(err) {
+			unasked[contract] = err
+		}
+		missing = append(missing, contract)
 	}
-
-	unix, err := strconv.Atoi(response.Result[0].TimeUnix)
-	if err != nil {
-		e.log.Error("Error decoding Atoi", zap.Error(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	response.Result[0].Timestamp = time.Unix(int64(unix), 0)
-
-	if len(response.Result) == 0 {
-		e.log.Debug("No deployment transaction found")
-		return nil, fmt.Errorf("%s: %s", op, "No deployment transaction found")
+	if len(missing) > 0 {
+		e.log.Debug("deployments unknown to source", zap.String("source", source.Name()), zap.Int("missing", len(missing)), zap.Int("asked", len(contracts)))
 	}
 
-	return &response.Result[0], nil
+	return missing
 }
@@ -0,0 +1,72 @@
+package explorer
+
+import (
+	"context"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+	"math/big"
+	"time"
+)
+
+var (
+	totalSupplySelector = []byte{0x18, 0x16, 0x0d, 0xdd}
+	decimalsSelector    = []byte{0x31, 0x3c, 0xe5, 0x67}
+	balanceOfSelector   = []byte{0x70, 0xa0, 0x82, 0x31}
+)
+
+func (e *Explorer) IsERC20(ctx context.Context, contract *ent.Contract) bool {
+	ok, _ := e.isERC20(ctx, contract.Network, common.HexToAddress(contract.Address))
+	return ok
+}
+
+// isERC20 probes the view functions every ERC-20 token has: totalSupply, decimals and balanceOf.
+// ERC-20 has no ERC-165 interface id, so a contract answering all of them with a single word is taken for a token.
+// Only transient failures of the probes are returned.
+func (e *Explorer) isERC20(ctx context.Context, network string, contractAddress common.Address) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	var results [3][]byte
+	for i, call := range erc20Probes(contractAddress) {
+		result, err := e.clients[network].CallContract(ctx, ethereum.CallMsg{To: &contractAddress, Data: call.CallData}, nil)
+		if err != nil {
+			if err := classify(err); ent.Transient(err) {
+				return false, fmt.Errorf("failed to call %s of %s: %w", erc20ProbeNames[i], contractAddress, err)
+			} else if !expected(err) {
+				e.log.Debug(fmt.Sprintf("Failed to call %s", erc20ProbeNames[i]), zap.String("addr", contractAddress.String()), zap.Error(err))
+			}
+			return false, nil
+		}
+		if len(result) != 32 {
+			return false, nil
+		}
+		results[i] = result
+	}
+
+	return erc20Answers(results[0], results[1], results[2]), nil
+}
+
+var erc20ProbeNames = [3]string{"totalSupply", "decimals", "balanceOf"}
+
+// erc20Probes are the calls of totalSupply, decimals and balanceOf of the zero address, in this order.
+func erc20Probes(contractAddress common.Address) [3]call3 {
+	balanceOf := append(append([]byte{}, balanceOfSelector...), common.LeftPadBytes(nil, 32)...)
+
+	return [3]call3{
+		{Target: contractAddress, CallData: totalSupplySelector},
+		{Target: contractAddress, CallData: decimalsSelector},
+		{Target: contractAddress, CallData: balanceOf},
+	}
+}
+
+// erc20Answers reports whether the answers to the ERC-20 probes are single words with sane decimals.
+func erc20Answers(totalSupply, decimals, balanceOf []byte) bool {
+	if len(totalSupply) != 32 || len(decimals) != 32 || len(balanceOf) != 32 {
+		return false
+	}
+
+	return new(big.Int).SetBytes(decimals).Cmp(big.NewInt(255)) <= 0
+}
@@ -0,0 +1,80 @@
+package explorer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum/rpc"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// revertedCode is the JSON-RPC error code geth answers a reverted eth_call with.
+const revertedCode = 3
+
+// classify wraps the error of a request to the node with the kind of failure it is,
+// an error of no known kind is returned as it is.
+func classify(err error) error {
+	if kind := failureKind(err); kind != nil {
+		return fmt.Errorf("%w: %w", kind, err)
+	}
+	return err
+}
+
+func failureKind(err error) error {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ent.ErrTimeout
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		switch {
+		case httpErr.StatusCode == http.StatusTooManyRequests:
+			return ent.ErrRateLimited
+		case httpErr.StatusCode >= http.StatusInternalServerError:
+			return ent.ErrUpstreamDown
+		}
+		return nil
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return ent.ErrTimeout
+		}
+		return ent.ErrUpstreamDown
+	}
+
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return nil
+	}
+	if rpcErr.ErrorCode() == revertedCode {
+		return ent.ErrReverted
+	}
+
+	// nodes other than geth, and geth itself for failures other than REVERT, only tell by the message
+	msg := strings.ToLower(rpcErr.Error())
+	for _, s := range []string{"execution reverted", "invalid opcode", "invalid jump destination", "out of gas"} {
+		if strings.Contains(msg, s) {
+			return ent.ErrReverted
+		}
+	}
+	for _, s := range []string{"rate limit", "too many requests"} {
+		if strings.Contains(msg, s) {
+			return ent.ErrRateLimited
+		}
+	}
+
+	return nil
+}
+
+// expected reports whether the failure is an answer about the contract rather than a problem to log.
+func expected(err error) bool {
+	return errors.Is(err, ent.ErrReverted) || errors.Is(err, ent.ErrUnsupported) || errors.Is(err, ent.ErrNotContract)
+}
@@ -0,0 +1,47 @@
+package explorer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum/rpc"
+	"net"
+	"testing"
+)
+
+type jsonError struct {
+	code int
+	msg  string
+}
+
+func (e jsonError) Error() string  { return e.msg }
+func (e jsonError) ErrorCode() int { return e.code }
+
+func Test_failureKind(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "deadline", err: fmt.Errorf("eth_call: %w", context.DeadlineExceeded), want: ent.ErrTimeout},
+		{name: "canceled", err: context.Canceled, want: nil},
+		{name: "too many requests", err: rpc.HTTPError{StatusCode: 429}, want: ent.ErrRateLimited},
+		{name: "bad gateway", err: rpc.HTTPError{StatusCode: 502}, want: ent.ErrUpstreamDown},
+		{name: "bad request", err: rpc.HTTPError{StatusCode: 400}, want: nil},
+		{name: "dial timeout", err: &net.DNSError{IsTimeout: true}, want: ent.ErrTimeout},
+		{name: "connection refused", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, want: ent.ErrUpstreamDown},
+		{name: "revert code", err: jsonError{code: 3, msg: "execution reverted"}, want: ent.ErrReverted},
+		{name: "invalid opcode", err: jsonError{code: -32000, msg: "invalid opcode: INVALID"}, want: ent.ErrReverted},
+		{name: "rate limit message", err: jsonError{code: -32005, msg: "Rate limit exceeded"}, want: ent.ErrRateLimited},
+		{name: "other json error", err: jsonError{code: -32602, msg: "invalid argument"}, want: nil},
+		{name: "plain", err: errors.New("boom"), want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := failureKind(tt.err); got != tt.want {
+				t.Errorf("failureKind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,37 +1,26 @@
 package explorer
 
 import (
-	"github.com/ethereum/go-ethereum/ethclient"
+	i "git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
 	"go.uber.org/zap"
-	"time"
+	"sync"
 )
 
 type Explorer struct {
-	etherScanKey string
-	clients      map[string]*ethclient.Client
-	tokens       chan struct{}
-	log          *zap.Logger
+	clients map[string]i.Client
+	// sources are asked for deployments per network in order.
+	sources map[string][]i.DeploymentSource
+	// multicall remembers per network whether Multicall3 is deployed there.
+	multicall sync.Map
+	log       *zap.Logger
 }
 
-func NewTokenDetector(clients map[string]*ethclient.Client, logger *zap.Logger) *Explorer {
-	tokens := make(chan struct{}, 5)
-	go func() {
-		ticker := time.NewTicker(time.Second)
-
-		for {
-			select {
-			case <-ticker.C:
-				for i := 0; i < 5; i++ {
-					tokens <- struct{}{}
-				}
-			}
-		}
-	}()
-
+// NewTokenDetector creates an Explorer. The deployments of contracts are asked from the sources of their network
+// and searched for on the node if the sources do not know them.
+func NewTokenDetector(clients map[string]i.Client, sources map[string][]i.DeploymentSource, logger *zap.Logger) *Explorer {
 	return &Explorer{
-		clients:      clients,
-		log:          logger,
-		etherScanKey: "MR1U8E6ZVFY534W81WEQ7KUT6JAATTP9M1",
-		tokens:       tokens,
+		clients: clients,
+		sources: sources,
+		log:     logger,
 	}
 }
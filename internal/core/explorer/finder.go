@@ -0,0 +1,205 @@
+package explorer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// callFrame is a frame of the geth callTracer output.
+type callFrame struct {
+	Type  string         `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Calls []callFrame    `json:"calls"`
+}
+
+// txTrace is the callTracer output for one transaction of debug_traceBlockByNumber.
+type txTrace struct {
+	TxHash common.Hash `json:"txHash"`
+	Result callFrame   `json:"result"`
+}
+
+// findDeployment finds the deployment of the contract on the node alone: the creation block is
+// the first one the contract has code at, found by binary search over eth_getCode, which needs an archive node.
+// The creating transaction is then found by the receipts of the block, or by its call traces for
+// contracts created by factories. A contract redeployed after a selfdestruct is found at its last deployment
+// at best, since the search needs the code to stay once deployed.
+func (e *Explorer) findDeployment(ctx context.Context, contract *ent.Contract) (*ent.Deployment, error) {
+	const op = "explorer.findDeployment"
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	client := e.clients[contract.Network]
+	addr := common.HexToAddress(contract.Address)
+
+	upper := contract.FoundAt()
+	if upper == nil {
+		head, err := client.BlockNumber(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to get last block: %w", op, classify(err))
+		}
+		upper = new(big.Int).SetUint64(head)
+	}
+
+	hasCode := func(num uint64) (bool, error) {
+		code, err := client.CodeAt(ctx, addr, new(big.Int).SetUint64(num))
+		if err != nil {
+			if prunedState(err) {
+				return false, fmt.Errorf("%w: state of block %d is pruned: %s", ent.ErrNOTOK, num, err)
+			}
+			return false, fmt.Errorf("failed to get code at %d: %w", num, classify(err))
+		}
+		return len(code) > 0, nil
+	}
+
+	if ok, err := hasCode(upper.Uint64()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	} else if !ok {
+		return nil, fmt.Errorf("%s: %s has no code: %w", op, addr, ent.ErrNotContract)
+	}
+
+	created, err := searchFirst(0, upper.Uint64(), hasCode)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	block, err := client.BlockByNumber(ctx, new(big.Int).SetUint64(created))
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get block %d: %w", op, created, classify(err))
+	}
+
+	deployment := &ent.Deployment{
+		BlockNumber: strconv.FormatUint(created, 10),
+		TimeUnix:    strconv.FormatUint(block.Time(), 10),
+		Timestamp:   time.Unix(int64(block.Time()), 0),
+	}
+
+	tx, factory, err := e.creation(ctx, contract, block)
+	if err != nil {
+		// the block alone is enough to index the contract from
+		e.log.Debug("failed to find creating transaction", zap.String("addr", contract.Address), zap.Uint64("block", created), zap.Error(err))
+		return deployment, nil
+	}
+	if tx == nil {
+		e.log.Debug("creating transaction not found", zap.String("addr", contract.Address), zap.Uint64("block", created))
+		return deployment, nil
+	}
+
+	deployment.TxHash = tx.Hash().Hex()
+	if sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(contract.ChainID)), tx); err == nil {
+		deployment.ContractCreator = sender.Hex()
+	}
+	switch {
+	case tx.To() == nil:
+		deployment.CreationByteCode = hexutil.Encode(tx.Data())
+	case factory != (common.Address{}):
+		deployment.ContractFactory = factory.Hex()
+	}
+
+	return deployment, nil
+}
+
+// creation returns the transaction of the block that created the contract and the factory it was created by,
+// the factory is zero for a contract deployed by a transaction directly or when no trace proves it.
+func (e *Explorer) creation(ctx context.Context, contract *ent.Contract, block *types.Block) (*types.Transaction, common.Address, error) {
+	client := e.clients[contract.Network]
+	addr := common.HexToAddress(contract.Address)
+	txs := block.Transactions()
+
+	receipts, err := client.BlockReceipts(ctx, block)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("failed to get receipts: %w", err)
+	}
+
+	for i, receipt := range receipts {
+		if receipt.ContractAddress == addr && txs[i].To() == nil {
+			return txs[i], common.Address{}, nil
+		}
+	}
+
+	var traces []txTrace
+	err = client.CallContext(ctx, &traces, "debug_traceBlockByNumber", hexutil.EncodeBig(block.Number()), map[string]string{"tracer": "callTracer"})
+	if err == nil && len(traces) == len(txs) {
+		for i := range traces {
+			if factory, ok := createdBy(&traces[i].Result, addr); ok {
+				return txs[i], factory, nil
+			}
+		}
+		return nil, common.Address{}, nil
+	}
+	if err != nil {
+		e.log.Debug("failed to trace block", zap.Uint64("block", block.NumberU64()), zap.Error(err))
+	}
+
+	// without traces, the transaction the new contract emits its first logs in is the best guess,
+	// constructors usually emit Transfer or OwnershipTransferred. The called contract is not known
+	// to be the factory, it may only route the call to it, so the factory is left empty.
+	for i, receipt := range receipts {
+		for _, logEntry := range receipt.Logs {
+			if logEntry.Address == addr && txs[i].To() != nil {
+				return txs[i], common.Address{}, nil
+			}
+		}
+	}
+
+	return nil, common.Address{}, nil
+}
+
+// createdBy looks for the CREATE or CREATE2 frame of the contract in the call tree and returns the creating contract.
+func createdBy(frame *callFrame, addr common.Address) (common.Address, bool) {
+	for i := range frame.Calls {
+		call := &frame.Calls[i]
+		if (call.Type == "CREATE" || call.Type == "CREATE2") && call.To == addr {
+			return call.From, true
+		}
+		if factory, ok := createdBy(call, addr); ok {
+			return factory, true
+		}
+	}
+	return common.Address{}, false
+}
+
+// searchFirst returns the lowest number in [lo, hi] that has holds for.
+// has must hold for hi and keep holding for every number above the first one.
+func searchFirst(lo, hi uint64, has func(uint64) (bool, error)) (uint64, error) {
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+
+		ok, err := has(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return hi, nil
+}
+
+// prunedState reports whether the node does not keep the state of old blocks.
+func prunedState(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"missing trie node", "historical state", "pruned", "state not available", "header not found"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package explorer
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"testing"
+)
+
+func Test_searchFirst(t *testing.T) {
+	tests := []struct {
+		name     string
+		lo, hi   uint64
+		deployed uint64
+	}{
+		{name: "middle", lo: 0, hi: 20_000_000, deployed: 12_345_678},
+		{name: "genesis", lo: 0, hi: 1000, deployed: 0},
+		{name: "last block", lo: 0, hi: 1000, deployed: 1000},
+		{name: "single block", lo: 7, hi: 7, deployed: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got, err := searchFirst(tt.lo, tt.hi, func(num uint64) (bool, error) {
+				calls++
+				return num >= tt.deployed, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.deployed {
+				t.Errorf("searchFirst() = %v, want %v", got, tt.deployed)
+			}
+			if calls > 64 {
+				t.Errorf("searchFirst() made %d calls", calls)
+			}
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		pruned := errors.New("missing trie node")
+		if _, err := searchFirst(0, 100, func(uint64) (bool, error) { return false, pruned }); !errors.Is(err, pruned) {
+			t.Errorf("searchFirst() error = %v, want %v", err, pruned)
+		}
+	})
+}
+
+func Test_createdBy(t *testing.T) {
+	factory := common.HexToAddress("0xfac0000000000000000000000000000000000000")
+	contract := common.HexToAddress("0xc011000000000000000000000000000000000000")
+	other := common.HexToAddress("0x0700000000000000000000000000000000000000")
+
+	trace := &callFrame{Type: "CALL", To: other, Calls: []callFrame{
+		{Type: "STATICCALL", From: other, To: factory},
+		{Type: "CALL", From: other, To: factory, Calls: []callFrame{
+			{Type: "CREATE2", From: factory, To: contract},
+		}},
+	}}
+
+	if got, ok := createdBy(trace, contract); !ok || got != factory {
+		t.Errorf("createdBy() = %v, %v, want %v", got, ok, factory)
+	}
+	if _, ok := createdBy(trace, other); ok {
+		t.Errorf("createdBy() found a contract that was called, not created")
+	}
+}
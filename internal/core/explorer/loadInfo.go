@@ -4,25 +4,59 @@ import (
 	"context"
 	"fmt"
 	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
 )
 
 func (e *Explorer) LoadInfo(ctx context.Context, contract *ent.Contract) error {
-	_type, err := e.Type(ctx, contract)
-	if err != nil {
-		return fmt.Errorf("failed to define type for %s, %s: %w", contract.Network, contract.Address, err)
+	return e.LoadInfos(ctx, []*ent.Contract{contract})[0]
+}
+
+// LoadInfos detects the types of the contracts and then looks up their deployments together,
+// in batches where the deployment source allows it. It returns the error of each contract.
+// The proxy a contract was resolved as while detecting its type is kept in it, even if the type is unknown.
+func (e *Explorer) LoadInfos(ctx context.Context, contracts []*ent.Contract) []error {
+	errs := make([]error, len(contracts))
+	types := make([]string, len(contracts))
+
+	var detected []*ent.Contract
+	for i, contract := range contracts {
+		types[i], contract.Proxy, errs[i] = e.detectType(ctx, contract)
+		if errs[i] == nil {
+			detected = append(detected, contract)
+		}
 	}
 
-	if _type == ent.UnknownType {
-		return fmt.Errorf("unknown type of contract: %s, %s", contract.Network, contract.Address)
+	deployments := e.deployments(ctx, detected)
+	for i, contract := range contracts {
+		if errs[i] != nil {
+			continue
+		}
+
+		d := deployments[contract]
+		if d.err != nil {
+			errs[i] = fmt.Errorf("failed to get deployment for %s, %s: %w", contract.Network, contract.Address, d.err)
+			continue
+		}
+
+		contract.Deployment = d.deployment
+		contract.Type = types[i]
 	}
 
-	deployments, err := e.etherscanDeployment(ctx, contract.ChainID, contract.Address)
+	return errs
+}
+
+// detectType returns the type of the contract and the proxy it was resolved as, the proxy even if the type is unknown.
+func (e *Explorer) detectType(ctx context.Context, contract *ent.Contract) (string, *ent.Proxy, error) {
+	detection, err := e.Detect(ctx, contract)
 	if err != nil {
-		return fmt.Errorf("failed to get deployment for %s, %s: %w", contract.Network, contract.Address, err)
+		return "", nil, fmt.Errorf("failed to define type for %s, %s: %w", contract.Network, contract.Address, err)
 	}
 
-	contract.Deployment = deployments
-	contract.Type = _type
+	e.log.Debug("contract type detected", zap.String("addr", contract.Address), zap.String("type", detection.Type), zap.String("reason", string(detection.Reason)))
+
+	if detection.Type == ent.UnknownType {
+		return "", detection.Proxy, fmt.Errorf("%w: %s, %s", ent.ErrUnknownType, contract.Network, contract.Address)
+	}
 
-	return nil
+	return detection.Type, detection.Proxy, nil
 }
@@ -0,0 +1,102 @@
+package explorer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const collectionABI = `[
+{"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},
+{"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},
+{"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+{"inputs":[],"name":"contractURI","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"name":"tokenId","type":"uint256"},{"name":"salePrice","type":"uint256"}],"name":"royaltyInfo","outputs":[{"name":"receiver","type":"address"},{"name":"royaltyAmount","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
+
+// royaltySalePrice makes the ERC-2981 royalty amount come out in basis points.
+var royaltySalePrice = big.NewInt(10_000)
+
+var collection = mustABI(collectionABI)
+
+// Metadata reads the name, symbol, total supply, contract URI and ERC-2981 royalty of the collection
+// in a single Multicall3 call. The functions the contract does not implement are left empty.
+func (e *Explorer) Metadata(ctx context.Context, contract *ent.Contract) (*ent.Metadata, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	addr := common.HexToAddress(contract.Address)
+
+	royaltyInfo, err := collection.Pack("royaltyInfo", big.NewInt(0), royaltySalePrice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack royaltyInfo: %w", err)
+	}
+
+	methods := []string{"name", "symbol", "totalSupply", "contractURI", "royaltyInfo"}
+	calls := make([]call3, len(methods))
+	for i, method := range methods {
+		calls[i] = call3{Target: addr, CallData: collection.Methods[method].ID}
+	}
+	calls[4].CallData = royaltyInfo
+
+	results, err := e.aggregate(ctx, contract.Network, calls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metadata of %s: %w", contract.Address, err)
+	}
+
+	m := &ent.Metadata{ChainID: contract.ChainID, Address: contract.Address, UpdatedAt: time.Now()}
+	m.Name = decodeString("name", results[0])
+	m.Symbol = decodeString("symbol", results[1])
+	m.ContractURI = decodeString("contractURI", results[3])
+
+	if values := unpack("totalSupply", results[2]); len(values) == 1 {
+		m.TotalSupply, _ = values[0].(*big.Int)
+	}
+	if values := unpack("royaltyInfo", results[4]); len(values) == 2 {
+		receiver, _ := values[0].(common.Address)
+		amount, _ := values[1].(*big.Int)
+		if receiver != (common.Address{}) && amount != nil {
+			m.RoyaltyReceiver, m.RoyaltyBps = receiver.String(), amount
+		}
+	}
+
+	return m, nil
+}
+
+// unpack decodes the outputs of a successful call, it returns nil for a failed or malformed one.
+func unpack(method string, result result3) []interface{} {
+	if !result.Success || len(result.ReturnData) == 0 {
+		return nil
+	}
+
+	values, err := collection.Unpack(method, result.ReturnData)
+	if err != nil {
+		return nil
+	}
+	return values
+}
+
+// decodeString decodes a string output. Early contracts such as MakerDAO return bytes32 instead,
+// which is accepted as long as it is valid text.
+func decodeString(method string, result result3) string {
+	if values := unpack(method, result); len(values) == 1 {
+		s, _ := values[0].(string)
+		// postgres text cannot hold NUL
+		return strings.ToValidUTF8(strings.ReplaceAll(s, "\x00", ""), "")
+	}
+
+	if result.Success && len(result.ReturnData) == 32 {
+		raw := bytes.TrimRight(result.ReturnData, "\x00")
+		if utf8.Valid(raw) && bytes.IndexByte(raw, 0) < 0 {
+			return string(raw)
+		}
+	}
+
+	return ""
+}
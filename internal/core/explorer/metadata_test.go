@@ -0,0 +1,32 @@
+package explorer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"testing"
+)
+
+func Test_decodeString(t *testing.T) {
+	encoded, err := collection.Methods["name"].Outputs.Pack("Bored Ape Yacht Club")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		result result3
+		want   string
+	}{
+		{name: "string", result: result3{Success: true, ReturnData: encoded}, want: "Bored Ape Yacht Club"},
+		{name: "bytes32", result: result3{Success: true, ReturnData: common.RightPadBytes([]byte("MKR"), 32)}, want: "MKR"},
+		{name: "binary bytes32", result: result3{Success: true, ReturnData: common.LeftPadBytes([]byte{0xff, 0x01}, 32)}, want: ""},
+		{name: "reverted", result: result3{Success: false, ReturnData: encoded}, want: ""},
+		{name: "not implemented", result: result3{Success: true}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decodeString("name", tt.result); got != tt.want {
+				t.Errorf("decodeString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package explorer
+
+import (
+	"context"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+	"strings"
+)
+
+// aggregateChunk is how many calls go in one aggregate3, so a batch stays well within the gas cap of eth_call.
+const aggregateChunk = 100
+
+// multicall3 is deployed at the same address on every chain that has it.
+var multicall3 = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+const multicall3ABI = `[{"inputs":[{"components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}],"name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}],"name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var multicallABI = mustABI(multicall3ABI)
+
+// call3 is a call aggregated by Multicall3, it may fail without failing the others.
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// result3 is the outcome of a call3.
+type result3 struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// aggregate runs the calls through Multicall3 aggregate3, every call is allowed to fail.
+// Chains without Multicall3 get the calls one by one.
+func (e *Explorer) aggregate(ctx context.Context, network string, calls []call3) ([]result3, error) {
+	if !e.hasMulticall(ctx, network) {
+		return e.callEach(ctx, network, calls)
+	}
+
+	results := make([]result3, 0, len(calls))
+	for start := 0; start < len(calls); start += aggregateChunk {
+		chunk := calls[start:min(start+aggregateChunk, len(calls))]
+
+		out, err := e.aggregate3(ctx, network, chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		// a call failing without data may have burnt all the gas it was given, which starves the calls after it
+		starved := false
+		for i := range out {
+			if out[i].Success {
+				continue
+			}
+			if starved {
+				if out[i], err = e.callOne(ctx, network, chunk[i]); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			starved = len(out[i].ReturnData) == 0
+		}
+
+		results = append(results, out...)
+	}
+
+	return results, nil
+}
+
+func (e *Explorer) aggregate3(ctx context.Context, network string, calls []call3) ([]result3, error) {
+	for i := range calls {
+		calls[i].AllowFailure = true
+	}
+
+	data, err := multicallABI.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack aggregate3: %w", err)
+	}
+
+	out, err := e.clients[network].CallContract(ctx, ethereum.CallMsg{To: &multicall3, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call aggregate3: %w", classify(err))
+	}
+
+	values, err := multicallABI.Unpack("aggregate3", out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack aggregate3: %w", err)
+	}
+
+	results := *abi.ConvertType(values[0], new([]result3)).(*[]result3)
+	if len(results) != len(calls) {
+		return nil, fmt.Errorf("aggregate3 returned %d results for %d calls", len(results), len(calls))
+	}
+
+	return results, nil
+}
+
+// hasMulticall reports whether Multicall3 is deployed on the network, the answer is remembered once it is known.
+func (e *Explorer) hasMulticall(ctx context.Context, network string) bool {
+	if deployed, ok := e.multicall.Load(network); ok {
+		return deployed.(bool)
+	}
+
+	code, err := e.clients[network].CodeAt(ctx, multicall3, nil)
+	if err != nil {
+		e.log.Debug("failed to look for multicall3", zap.String("network", network), zap.Error(err))
+		return false
+	}
+
+	deployed := len(code) > 0
+	e.multicall.Store(network, deployed)
+	if !deployed {
+		e.log.Info("multicall3 is not deployed, calls are not aggregated", zap.String("network", network))
+	}
+
+	return deployed
+}
+
+// callEach makes the calls one by one, it gives up on the first one that fails for a transient reason.
+func (e *Explorer) callEach(ctx context.Context, network string, calls []call3) ([]result3, error) {
+	results := make([]result3, len(calls))
+	for i := range calls {
+		var err error
+		if results[i], err = e.callOne(ctx, network, calls[i]); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// callOne makes the call as aggregate3 would, a call the contract fails is an unsuccessful result
+// and a call the node fails for a transient reason is an error.
+func (e *Explorer) callOne(ctx context.Context, network string, call call3) (result3, error) {
+	out, err := e.clients[network].CallContract(ctx, ethereum.CallMsg{To: &call.Target, Data: call.CallData}, nil)
+	if err != nil {
+		if err := classify(err); ent.Transient(err) {
+			return result3{}, fmt.Errorf("failed to call %s: %w", call.Target, err)
+		}
+		return result3{}, nil
+	}
+	return result3{Success: true, ReturnData: out}, nil
+}
+
+func mustABI(definition string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
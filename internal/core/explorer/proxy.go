@@ -0,0 +1,131 @@
+package explorer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+	"time"
+)
+
+var (
+	// EIP-1967 slots: keccak256("eip1967.proxy.implementation") - 1 and keccak256("eip1967.proxy.beacon") - 1.
+	implementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	beaconSlot         = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+	// proxiableSlot is keccak256("PROXIABLE"), where the first EIP-1822 proxies kept the implementation.
+	proxiableSlot = common.HexToHash("0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7")
+
+	implementationSelector = []byte{0x5c, 0x60, 0xda, 0x1b}
+	proxiableUUIDSelector  = []byte{0x52, 0xd1, 0x90, 0x2d}
+
+	// EIP-1167 runtime bytecode around the 20-byte implementation address.
+	clonePrefix = common.FromHex("0x363d3d373d3d3d363d73")
+	cloneSuffix = common.FromHex("0x5af43d82803e903d91602b57fd5bf3")
+)
+
+// ResolveProxy tells whether the contract is a proxy and which implementation it delegates to.
+// EIP-1167 clones are recognised by their bytecode, the other proxies by the EIP-1967 and EIP-1822 slots.
+func (e *Explorer) ResolveProxy(ctx context.Context, contract *ent.Contract) (*ent.Proxy, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+
+	client := e.clients[contract.Network]
+	addr := common.HexToAddress(contract.Address)
+	proxy := &ent.Proxy{ChainID: contract.ChainID, Address: addr.String(), CheckedAt: time.Now()}
+
+	code, err := client.CodeAt(ctx, addr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get code of %s: %w", addr, err)
+	}
+	if impl, ok := cloneTarget(code); ok {
+		proxy.Kind, proxy.Implementation = ent.ProxyClone, impl.String()
+		return proxy, nil
+	}
+
+	impl, err := e.slot(ctx, contract.Network, addr, implementationSlot)
+	if err != nil {
+		return nil, err
+	}
+	if impl != (common.Address{}) {
+		proxy.Kind, proxy.Implementation = ent.ProxyEIP1967, impl.String()
+		if e.proxiable(ctx, contract.Network, impl) {
+			proxy.Kind = ent.ProxyUUPS
+		}
+		return proxy, nil
+	}
+
+	beacon, err := e.slot(ctx, contract.Network, addr, beaconSlot)
+	if err != nil {
+		return nil, err
+	}
+	if beacon != (common.Address{}) {
+		result, err := client.CallContract(ctx, ethereum.CallMsg{To: &beacon, Data: implementationSelector}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get implementation of beacon %s: %w", beacon, err)
+		}
+		impl, ok := wordAddress(result)
+		if !ok {
+			return nil, fmt.Errorf("beacon %s returned no implementation", beacon)
+		}
+		proxy.Kind, proxy.Implementation, proxy.Beacon = ent.ProxyBeacon, impl.String(), beacon.String()
+		return proxy, nil
+	}
+
+	impl, err = e.slot(ctx, contract.Network, addr, proxiableSlot)
+	if err != nil {
+		return nil, err
+	}
+	if impl != (common.Address{}) {
+		proxy.Kind, proxy.Implementation = ent.ProxyEIP1822, impl.String()
+		return proxy, nil
+	}
+
+	return nil, nil
+}
+
+// slot reads an address from a storage slot of the contract, the zero address means the slot is unused.
+func (e *Explorer) slot(ctx context.Context, network string, contractAddress common.Address, slot common.Hash) (common.Address, error) {
+	word, err := e.clients[network].StorageAt(ctx, contractAddress, slot, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to read slot %s of %s: %w", slot, contractAddress, err)
+	}
+
+	addr, _ := wordAddress(word)
+	return addr, nil
+}
+
+// proxiable reports whether the implementation answers proxiableUUID with the EIP-1967 slot, as UUPS implementations do.
+func (e *Explorer) proxiable(ctx context.Context, network string, implementation common.Address) bool {
+	result, err := e.clients[network].CallContract(ctx, ethereum.CallMsg{To: &implementation, Data: proxiableUUIDSelector}, nil)
+	if err != nil {
+		e.log.Debug("Failed to call proxiableUUID", zap.String("addr", implementation.String()), zap.Error(err))
+		return false
+	}
+
+	return bytes.Equal(result, implementationSlot.Bytes())
+}
+
+// wordAddress decodes an address from a 32-byte word, it fails on words that hold anything else.
+func wordAddress(word []byte) (common.Address, bool) {
+	if len(word) != 32 || !bytes.Equal(word[:12], make([]byte, 12)) {
+		return common.Address{}, false
+	}
+
+	addr := common.BytesToAddress(word[12:])
+	return addr, addr != common.Address{}
+}
+
+// cloneTarget returns the implementation an EIP-1167 minimal proxy delegates to.
+func cloneTarget(code []byte) (common.Address, bool) {
+	if len(code) != len(clonePrefix)+common.AddressLength+len(cloneSuffix) {
+		return common.Address{}, false
+	}
+	if !bytes.HasPrefix(code, clonePrefix) || !bytes.HasSuffix(code, cloneSuffix) {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(code[len(clonePrefix) : len(clonePrefix)+common.AddressLength]), true
+}
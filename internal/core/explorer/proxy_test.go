@@ -0,0 +1,55 @@
+package explorer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"testing"
+)
+
+func Test_cloneTarget(t *testing.T) {
+	impl := common.HexToAddress("0xbebebebebebebebebebebebebebebebebebebebe")
+	clone := append(append(append([]byte{}, clonePrefix...), impl.Bytes()...), cloneSuffix...)
+
+	tests := []struct {
+		name   string
+		code   []byte
+		want   common.Address
+		wantOk bool
+	}{
+		{name: "minimal proxy", code: clone, want: impl, wantOk: true},
+		{name: "no code", code: nil},
+		{name: "extended bytecode", code: append(append([]byte{}, clone...), 0x00)},
+		{name: "other contract", code: common.FromHex("0x6080604052348015600f57600080fd5b50")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := cloneTarget(tt.code)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("cloneTarget() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func Test_wordAddress(t *testing.T) {
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	tests := []struct {
+		name   string
+		word   []byte
+		want   common.Address
+		wantOk bool
+	}{
+		{name: "address", word: common.LeftPadBytes(addr.Bytes(), 32), want: addr, wantOk: true},
+		{name: "empty slot", word: make([]byte, 32)},
+		{name: "not an address", word: implementationSlot.Bytes()},
+		{name: "short", word: addr.Bytes()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := wordAddress(tt.word)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("wordAddress() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -14,63 +14,145 @@ import (
 	"time"
 )
 
+const (
+	// erc1155LogWindow is how many blocks up to the one a contract was found at are searched for its transfers.
+	// It stays within the eth_getLogs range limits of common upstreams.
+	erc1155LogWindow = 1000
+)
+
+var (
+	erc721InterfaceID  = [4]byte{0x80, 0xac, 0x58, 0xcd}
+	erc1155InterfaceID = [4]byte{0xd9, 0xb6, 0x7a, 0x26}
+)
+
 func (e *Explorer) Type(ctx context.Context, deployment *ent.Contract) (string, error) {
+	detection, err := e.Detect(ctx, deployment)
+	return detection.Type, err
+}
+
+// Detect tells the type of the contract and what evidence it rests on.
+// ERC-165 claims are checked first, then the transfer logs of the contract around the block
+// it was found at, then the view functions of ERC-20, then the ERC-165 claims of the implementation
+// if the contract is a proxy. It fails only if a check could not be made for a transient reason,
+// a contract that answers none of them is of the unknown type.
+func (e *Explorer) Detect(ctx context.Context, deployment *ent.Contract) (ent.Detection, error) {
 	addr := common.HexToAddress(deployment.Address)
 
-	if e.isERC721(ctx, deployment.Network, addr) {
-		return ent.ERC721Type, nil
+	// a contract seen emitting ERC-20 transfers is probed as a token first
+	if deployment.Type == ent.ERC20Type {
+		if ok, err := e.isERC20(ctx, deployment.Network, addr); err != nil {
+			return ent.Detection{}, err
+		} else if ok {
+			return ent.Detection{Type: ent.ERC20Type, Reason: ent.DetectedByProbes}, nil
+		}
+	}
+
+	if ok, err := e.isERC721(ctx, deployment.Network, addr); err != nil {
+		return ent.Detection{}, err
+	} else if ok {
+		return ent.Detection{Type: ent.ERC721Type, Reason: ent.DetectedByERC165}, nil
+	}
+	if ok, err := e.supports(ctx, deployment.Network, addr, erc1155InterfaceID, "ERC1155"); err != nil {
+		return ent.Detection{}, err
+	} else if ok {
+		return ent.Detection{Type: ent.ERC1155Type, Reason: ent.DetectedByERC165}, nil
 	}
-	if e.isERC1155(ctx, deployment.Network, addr) {
-		return ent.ERC1155Type, nil
+	if ok, err := e.emitsERC1155(ctx, deployment.Network, addr, deployment.FoundAt()); err != nil {
+		return ent.Detection{}, err
+	} else if ok {
+		return ent.Detection{Type: ent.ERC1155Type, Reason: ent.DetectedByLogs}, nil
+	}
+	if deployment.Type != ent.ERC20Type {
+		if ok, err := e.isERC20(ctx, deployment.Network, addr); err != nil {
+			return ent.Detection{}, err
+		} else if ok {
+			return ent.Detection{Type: ent.ERC20Type, Reason: ent.DetectedByProbes}, nil
+		}
 	}
 
-	return ent.UnknownType, nil
+	return e.detectImplementation(ctx, deployment)
+}
+
+// detectImplementation checks the ERC-165 claims of the implementation behind a proxy
+// that does not forward them itself. The proxy it resolved is kept in the detection.
+func (e *Explorer) detectImplementation(ctx context.Context, deployment *ent.Contract) (ent.Detection, error) {
+	unknown := ent.Detection{Type: ent.UnknownType, Reason: ent.NotDetected}
+
+	proxy, err := e.ResolveProxy(ctx, deployment)
+	if err != nil {
+		if err := classify(err); ent.Transient(err) {
+			return ent.Detection{}, fmt.Errorf("failed to resolve proxy %s: %w", deployment.Address, err)
+		}
+		e.log.Debug("failed to resolve proxy", zap.String("addr", deployment.Address), zap.Error(err))
+		return unknown, nil
+	}
+	if proxy == nil {
+		return unknown, nil
+	}
+	unknown.Proxy = proxy
+
+	impl := common.HexToAddress(proxy.Implementation)
+	if ok, err := e.isERC721(ctx, deployment.Network, impl); err != nil {
+		return ent.Detection{}, err
+	} else if ok {
+		return ent.Detection{Type: ent.ERC721Type, Reason: ent.DetectedByERC165, Proxy: proxy}, nil
+	}
+	if ok, err := e.supports(ctx, deployment.Network, impl, erc1155InterfaceID, "ERC1155"); err != nil {
+		return ent.Detection{}, err
+	} else if ok {
+		return ent.Detection{Type: ent.ERC1155Type, Reason: ent.DetectedByERC165, Proxy: proxy}, nil
+	}
+
+	return unknown, nil
 }
 
 func (e *Explorer) IsERC721(ctx context.Context, contract *ent.Contract) bool {
-	return e.isERC721(ctx, contract.Network, common.HexToAddress(contract.Address))
+	ok, _ := e.isERC721(ctx, contract.Network, common.HexToAddress(contract.Address))
+	return ok
 }
 
-func (e *Explorer) isERC721(ctx context.Context, network string, contractAddress common.Address) bool {
+func (e *Explorer) isERC721(ctx context.Context, network string, contractAddress common.Address) (bool, error) {
+	return e.supports(ctx, network, contractAddress, erc721InterfaceID, "ERC721")
+}
+
+// supports reports whether the contract claims the interface with ERC-165.
+// A contract that cannot answer does not support it, only transient failures are returned.
+func (e *Explorer) supports(ctx context.Context, network string, contractAddress common.Address, interfaceID [4]byte, name string) (bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*2)
 	defer cancel()
 
-	interfaceID := [4]byte{0x80, 0xac, 0x58, 0xcd}
 	result, err := e.callSupportsInterface(ctx, network, contractAddress, interfaceID)
-	if err != nil {
-		if !strings.Contains(err.Error(), "invalid opcode") && !strings.Contains(err.Error(), "invalid jump destination") && !strings.Contains(err.Error(), "unmarshal an empty string") {
-			e.log.Debug("Failed to check ERC721 support", zap.Error(err))
-		}
-		return false
+	switch {
+	case err == nil:
+		return result, nil
+	case ent.Transient(err):
+		return false, fmt.Errorf("failed to check %s support of %s: %w", name, contractAddress, err)
+	case !expected(err):
+		e.log.Debug(fmt.Sprintf("Failed to check %s support", name), zap.Error(err))
 	}
 
-	return result
+	return false, nil
 }
 
-func (e *Explorer) isERC1155(ctx context.Context, network string, contractAddress common.Address) bool {
-	ctx, cancel := context.WithTimeout(ctx, time.Second*20)
+// emitsERC1155 looks for ERC-1155 transfers of the contract in the blocks up to the one it was found at,
+// or up to the latest block if it is not known where the contract was found.
+func (e *Explorer) emitsERC1155(ctx context.Context, network string, contractAddress common.Address, foundAt *big.Int) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
 
-	//interfaceID := [4]byte{0xd9, 0xb6, 0x7a, 0x26}
-	//result, err := e.callSupportsInterface(ctx, network, contractAddress, interfaceID)
-	//if err != nil {
-	//	if !strings.Contains(err.Error(), "invalid opcode") && !strings.Contains(err.Error(), "invalid jump destination") {
-	//		e.log.Warn("Failed to check ERC1155 support", zap.Error(err))
-	//	}
-	//	return false
-	//}
-	//
-	//if result {
-	//	return true
-	//}
-
-	block, err := e.clients[network].BlockNumber(ctx)
-	if err != nil {
-		fmt.Println(err)
-
-		e.log.Warn("failed to get last block", zap.Error(err))
-		return false
+	to := foundAt
+	if to == nil {
+		block, err := e.clients[network].BlockNumber(ctx)
+		if err != nil {
+			if err := classify(err); ent.Transient(err) {
+				return false, fmt.Errorf("failed to get last block: %w", err)
+			}
+			e.log.Warn("failed to get last block", zap.Error(err))
+			return false, nil
+		}
+		to = new(big.Int).SetUint64(block)
 	}
+	from, to := logWindow(to, erc1155LogWindow)
 
 	transferSingleTopic := crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
 	transferBatchTopic := crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
@@ -78,57 +160,30 @@ func (e *Explorer) isERC1155(ctx context.Context, network string, contractAddres
 	q := ethereum.FilterQuery{
 		Addresses: []common.Address{contractAddress},
 		Topics:    [][]common.Hash{{transferSingleTopic, transferBatchTopic}},
-		FromBlock: new(big.Int).SetUint64(block - 50_000),
-		ToBlock:   new(big.Int).SetUint64(block),
+		FromBlock: from,
+		ToBlock:   to,
 	}
 
 	logs, err := e.clients[network].FilterLogs(ctx, q)
 	if err != nil {
+		if err := classify(err); ent.Transient(err) {
+			return false, fmt.Errorf("failed to get logs of %s: %w", contractAddress, err)
+		}
 		e.log.Warn("failed to get logs", zap.Error(err))
-		return false
+		return false, nil
 	}
 
-	return len(logs) > 0
+	return len(logs) > 0, nil
 }
 
-//func (e *Explorer) isERC20(ctx context.Context, network string, contractAddress common.Address) bool {
-//
-//	erc165ABI := `[{"constant":true,"inputs":[{"name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"}]`
-//	parsedABI, _ := abi.JSON(strings.NewReader(erc165ABI))
-//	erc20InterfaceID := [4]byte{0x36, 0x37, 0x2b, 0x07}
-//	data, _ := parsedABI.Pack("supportsInterface", erc20InterfaceID)
-//	result, _ := e.clients[network].CallContract(ctx, ethereum.CallMsg{
-//		To:   &contractAddress,
-//		Data: data,
-//	}, nil)
-//
-//
-//	abiJSON := `[{"constant":true,"inputs":[{"name":"_owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"}]`
-//
-//	Abi, err := abi.JSON(strings.NewReader(abiJSON))
-//	if err != nil {
-//		e.log.Debug("Failed to parse ABI for balanceOf", zap.Error(err))
-//		return false
-//	}
-//
-//	data, err = Abi.Pack("balanceOf", common.HexToAddress("0x0000000000000000000000000000000000000000"))
-//	if err != nil {
-//		e.log.Debug("Failed to pack data for balanceOf", zap.Error(err))
-//		return false
-//	}
-//
-//	msg := ethereum.CallMsg{
-//		To:   &contractAddress,
-//		Data: data,
-//	}
-//	result, err = e.clients[network].CallContract(ctx, msg, nil)
-//	if err != nil {
-//		e.log.Debug("Failed to call balanceOf", zap.Error(err))
-//		return false
-//	}
-//
-//	return len(result) > 0
-//}
+// logWindow returns the range of size blocks ending at to, it never starts below the genesis block.
+func logWindow(to *big.Int, size int64) (*big.Int, *big.Int) {
+	from := new(big.Int).Sub(to, big.NewInt(size-1))
+	if from.Sign() < 0 {
+		from.SetInt64(0)
+	}
+	return from, new(big.Int).Set(to)
+}
 
 func (e *Explorer) callSupportsInterface(ctx context.Context, network string, contractAddress common.Address, interfaceID [4]byte) (bool, error) {
 	abiJSON := `[{"constant":true,"inputs":[{"name":"interfaceId","type":"bytes4"}],"name":"supportsInterface","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"view","type":"function"}]`
@@ -150,10 +205,11 @@ func (e *Explorer) callSupportsInterface(ctx context.Context, network string, co
 
 	result, err := e.clients[network].CallContract(ctx, msg, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "execution reverted") {
-			return false, nil
-		}
-		return false, fmt.Errorf("call contract failed: %w", err)
+		return false, fmt.Errorf("call contract failed: %w", classify(err))
+	}
+	// an account without code, or a contract whose fallback takes the call, answers nothing
+	if len(result) == 0 {
+		return false, fmt.Errorf("%s: %w", contractAddress, ent.ErrUnsupported)
 	}
 
 	var supported bool
@@ -2,9 +2,11 @@ package explorer
 
 import (
 	"context"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/core/rpcpool"
 	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
-	"github.com/ethereum/go-ethereum/ethclient"
+	i "git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
 	"go.uber.org/zap"
+	"math/big"
 	"testing"
 )
 
@@ -17,12 +19,10 @@ var upstream = struct {
 }
 
 func TestExplorer_Type(t *testing.T) {
-	client, err := ethclient.Dial(upstream.url)
-	if err != nil {
-		panic(err)
-	}
+	client := rpcpool.NewPool(upstream.net, []string{upstream.url}, rpcpool.Limits{}, zap.NewNop())
+	defer client.Close()
 
-	var e = NewTokenDetector(map[string]*ethclient.Client{upstream.net: client}, zap.NewNop())
+	var e = NewTokenDetector(map[string]i.Client{upstream.net: client}, nil, zap.NewNop())
 
 	type args struct {
 		ctx        context.Context
@@ -113,3 +113,23 @@ func TestExplorer_Type(t *testing.T) {
 		})
 	}
 }
+
+func Test_logWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		to       int64
+		wantFrom int64
+	}{
+		{name: "deep in chain", to: 5_000_000, wantFrom: 4_999_001},
+		{name: "young chain", to: 300, wantFrom: 0},
+		{name: "genesis", to: 0, wantFrom: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := logWindow(big.NewInt(tt.to), 1000)
+			if from.Int64() != tt.wantFrom || to.Int64() != tt.to {
+				t.Errorf("logWindow() = [%v, %v], want [%v, %v]", from, to, tt.wantFrom, tt.to)
+			}
+		})
+	}
+}
@@ -35,20 +35,15 @@ func (g *Graph) RealExist() map[string]struct{} {
 	return filesMap
 }
 
-func (g *Graph) Init(contract string) error {
+func (g *Graph) Init(contract, _type string) error {
 	if err := os.Mkdir(g.path, 0644); err != nil {
 		if !os.IsExist(err) {
 			return err
 		}
 	}
 
-	cmd := exec.Command("graph", "init", g.network+"/"+contract, g.network+"/"+contract, "--from-contract", contract, "--network", g.network, "--skip-install", "--skip-git", "--abi", "../abi.json")
-	cmd.Dir = g.path
-	cmd.Stdout = io.Discard
-	cmd.Stderr = os.Stderr
-
 	g.log.Debug("graph-init")
-	return cmd.Run()
+	return g.scaffold(g.network, &entity.Contract{Address: contract, Type: _type})
 }
 
 func (g *Graph) Create(contract string) error {
@@ -79,15 +74,11 @@ func (g *Graph) CreateSubgraph(ctx context.Context, contract *entity.Contract) e
 		}
 	}
 
-	cmd := exec.Command("graph", "init", contract.Network+"/"+contract.Address, contract.Network+"/"+contract.Address, "--from-contract", contract.Address, "--network", contract.Network, "--skip-install", "--skip-git", "--abi", "../abi.json")
-	cmd.Dir = g.path
-	cmd.Stdout = io.Discard
-	cmd.Stderr = os.Stderr
-	if err := cmd.Run(); err != nil {
+	if err := g.scaffold(contract.Network, contract); err != nil {
 		return err
 	}
 
-	cmd = exec.Command("graph", "create", contract.Network+"/"+contract.Address, "--node", g.nodeURL)
+	cmd := exec.Command("graph", "create", contract.Network+"/"+contract.Address, "--node", g.nodeURL)
 	cmd.Dir = g.path + "/" + g.network + "/" + contract.Address
 
 	cmd.Stdout = io.Discard
@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"bytes"
+	"embed"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"text/template"
+)
+
+// templates holds the subgraphs of the contract types that are not scaffolded from the NFT ABI.
+//
+//go:embed templates
+var templates embed.FS
+
+// manifest is the file of a template filled in with the contract, the other files are copied as they are.
+const manifest = "subgraph.yaml"
+
+// templated returns the template directory of the type, or false if the type is scaffolded from the NFT ABI.
+func templated(_type string) (string, bool) {
+	if _type == entity.ERC20Type {
+		return "templates/erc20", true
+	}
+	return "", false
+}
+
+// source is what a template manifest is filled in with.
+type source struct {
+	Network    string
+	Address    string
+	StartBlock string
+}
+
+// render writes the template into dir with the manifest filled in from src.
+func render(name, dir string, src source) error {
+	return fs.WalkDir(templates, name, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(name, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+
+		content, err := templates.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if rel == manifest {
+			tmpl, err := template.New(manifest).Option("missingkey=error").Parse(string(content))
+			if err != nil {
+				return err
+			}
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, src); err != nil {
+				return err
+			}
+			content = buf.Bytes()
+		}
+
+		return os.WriteFile(target, content, 0644)
+	})
+}
+
+// scaffold creates the subgraph of the contract in path/network/address from the template of its type,
+// or with graph init from the NFT ABI.
+func (g *Graph) scaffold(network string, contract *entity.Contract) error {
+	name := network + "/" + contract.Address
+
+	tmpl, ok := templated(contract.Type)
+	if !ok {
+		cmd := exec.Command("graph", "init", name, name, "--from-contract", contract.Address, "--network", network, "--skip-install", "--skip-git", "--abi", "../abi.json")
+		cmd.Dir = g.path
+		cmd.Stdout = io.Discard
+		cmd.Stderr = os.Stderr
+		return cmd.Run()
+	}
+
+	src := source{Network: network, Address: contract.Address, StartBlock: "0"}
+	if contract.Deployment != nil && contract.Deployment.BlockNumber != "" {
+		src.StartBlock = contract.Deployment.BlockNumber
+	}
+
+	dir := filepath.Join(g.path, network, contract.Address)
+	if err := render(tmpl, dir, src); err != nil {
+		return err
+	}
+
+	// the mapping imports the types generated from the schema and the ABI
+	cmd := exec.Command("graph", "codegen")
+	cmd.Dir = dir
+	cmd.Stdout = io.Discard
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
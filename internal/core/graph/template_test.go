@@ -0,0 +1,40 @@
+package graph
+
+import (
+	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_render(t *testing.T) {
+	tmpl, ok := templated(entity.ERC20Type)
+	if !ok {
+		t.Fatal("no ERC-20 template")
+	}
+	if _, ok := templated(entity.ERC721Type); ok {
+		t.Error("ERC-721 contracts are scaffolded from the NFT ABI")
+	}
+
+	dir := t.TempDir()
+	if err := render(tmpl, dir, source{Network: "sepolia", Address: "0x0000000000000000000000000000000000000001", StartBlock: "42"}); err != nil {
+		t.Fatalf("render() error = %v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, manifest))
+	if err != nil {
+		t.Fatalf("manifest not rendered: %v", err)
+	}
+	for _, want := range []string{"network: sepolia", `address: "0x0000000000000000000000000000000000000001"`, "startBlock: 42"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("manifest lacks %q", want)
+		}
+	}
+
+	for _, file := range []string{"schema.graphql", "abis/ERC20.json", "src/mapping.ts"} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Errorf("%s not copied: %v", file, err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+import { Address, BigInt } from "@graphprotocol/graph-ts"
+import { ERC20, Approval as ApprovalEvent, Transfer as TransferEvent } from "../generated/ERC20/ERC20"
+import { Account, Approval, Token, Transfer } from "../generated/schema"
+
+function loadToken(address: Address): Token {
+  let token = Token.load(address)
+  if (token != null) {
+    return token
+  }
+
+  token = new Token(address)
+  token.totalSupply = BigInt.zero()
+
+  // name, symbol and decimals are optional in ERC-20
+  let contract = ERC20.bind(address)
+  let name = contract.try_name()
+  if (!name.reverted) {
+    token.name = name.value
+  }
+  let symbol = contract.try_symbol()
+  if (!symbol.reverted) {
+    token.symbol = symbol.value
+  }
+  let decimals = contract.try_decimals()
+  if (!decimals.reverted) {
+    token.decimals = decimals.value
+  }
+
+  return token
+}
+
+function loadAccount(address: Address): Account {
+  let account = Account.load(address)
+  if (account == null) {
+    account = new Account(address)
+    account.balance = BigInt.zero()
+  }
+  return account
+}
+
+export function handleTransfer(event: TransferEvent): void {
+  let token = loadToken(event.address)
+  let value = event.params.value
+
+  // mints come from and burns go to the zero address
+  if (event.params.from == Address.zero()) {
+    token.totalSupply = token.totalSupply.plus(value)
+  } else {
+    let from = loadAccount(event.params.from)
+    from.balance = from.balance.minus(value)
+    from.save()
+  }
+  if (event.params.to == Address.zero()) {
+    token.totalSupply = token.totalSupply.minus(value)
+  } else {
+    let to = loadAccount(event.params.to)
+    to.balance = to.balance.plus(value)
+    to.save()
+  }
+  token.save()
+
+  let transfer = new Transfer(event.transaction.hash.concatI32(event.logIndex.toI32()))
+  transfer.from = event.params.from
+  transfer.to = event.params.to
+  transfer.value = value
+  transfer.blockNumber = event.block.number
+  transfer.blockTimestamp = event.block.timestamp
+  transfer.transactionHash = event.transaction.hash
+  transfer.save()
+}
+
+export function handleApproval(event: ApprovalEvent): void {
+  let approval = new Approval(event.transaction.hash.concatI32(event.logIndex.toI32()))
+  approval.owner = event.params.owner
+  approval.spender = event.params.spender
+  approval.value = event.params.value
+  approval.blockNumber = event.block.number
+  approval.blockTimestamp = event.block.timestamp
+  approval.transactionHash = event.transaction.hash
+  approval.save()
+}
@@ -1,16 +1,40 @@
 package producer
 
-func (p *Producer) Exception(contract string) {
+import "time"
+
+func (p *Producer) Exception(contract string, until time.Time) {
 	p.Lock()
 	defer p.Unlock()
-	p.exceptions[contract] = struct{}{}
+	p.exceptions[contract] = until
+	// the exception decides from now on, the contract is produced again once it expires
+	p.known.Remove(contract)
 }
 
-func (p *Producer) excepted(contract string) bool {
-	p.RLock()
-	defer p.RUnlock()
+// Forgive stops skipping the contracts.
+func (p *Producer) Forgive(contracts ...string) {
+	p.Lock()
+	defer p.Unlock()
+
+	for _, contract := range contracts {
+		delete(p.exceptions, contract)
+		p.known.Remove(contract)
+	}
+}
 
-	_, exists := p.exceptions[contract]
+// ForgiveAll stops skipping every contract.
+func (p *Producer) ForgiveAll() {
+	p.Lock()
+	defer p.Unlock()
+
+	clear(p.exceptions)
+	p.known.Purge()
+}
+
+// Excepted reports whether the contract is skipped.
+func (p *Producer) Excepted(contract string) bool {
+	p.RLock()
+	until, exists := p.exceptions[contract]
+	p.RUnlock()
 
-	return exists
+	return exists && time.Now().Before(until)
 }
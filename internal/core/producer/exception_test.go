@@ -0,0 +1,32 @@
+package producer
+
+import (
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestProducer_Excepted(t *testing.T) {
+	p := NewProducer(nil, zap.NewNop(), "sepolia", Settings{})
+	p.Exception("0xactive", time.Now().Add(time.Hour))
+	p.Exception("0xexpired", time.Now().Add(-time.Hour))
+	p.Exception("0xforgiven", time.Now().Add(time.Hour))
+	p.Forgive("0xforgiven")
+
+	tests := []struct {
+		contract string
+		want     bool
+	}{
+		{contract: "0xactive", want: true},
+		{contract: "0xexpired", want: false},
+		{contract: "0xforgiven", want: false},
+		{contract: "0xunknown", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contract, func(t *testing.T) {
+			if got := p.Excepted(tt.contract); got != tt.want {
+				t.Errorf("Excepted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"go.uber.org/zap"
+	"math/big"
+	"time"
+)
+
+const (
+	dialTimeout = 10 * time.Second
+	// followBuffer is how many new heads are buffered, a producer further behind unsubscribes while catching up.
+	followBuffer = 16
+)
+
+var errSubscriptionDropped = errors.New("newHeads subscription dropped")
+
+// follow waits on the newHeads subscription until the block becomes safe.
+// It returns false if the producer was stopped meanwhile.
+func (p *Producer) follow(ctx context.Context, num *big.Int) (bool, error) {
+	if err := p.subscribe(ctx); err != nil {
+		return true, err
+	}
+	p.drain()
+
+	for {
+		select {
+		case <-p.done:
+			p.unsubscribe()
+			return false, nil
+		case err := <-p.sub.Err():
+			p.unsubscribe()
+			return true, fmt.Errorf("%w: %v", errSubscriptionDropped, err)
+		case header := <-p.heads:
+			head, err := p.safeHeadAt(ctx, header)
+			if err != nil {
+				return true, err
+			}
+			// a head buffered before the last poll never moves the safe head back
+			if head.Cmp(p.head) <= 0 {
+				continue
+			}
+			p.head = head
+
+			if num.Cmp(p.head) <= 0 {
+				return true, nil
+			}
+		}
+	}
+}
+
+// subscribe dials the websocket upstream and subscribes to new heads unless it is subscribed already.
+func (p *Producer) subscribe(ctx context.Context) error {
+	if p.sub != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, p.followURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", p.network, err)
+	}
+
+	heads := make(chan *types.Header, followBuffer)
+	sub, err := client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		client.Close()
+		return fmt.Errorf("failed to subscribe to new heads: %w", err)
+	}
+
+	p.log.Info("following new heads")
+	p.wsClient, p.sub, p.heads = client, sub, heads
+	return nil
+}
+
+func (p *Producer) unsubscribe() {
+	if p.sub == nil {
+		return
+	}
+
+	p.sub.Unsubscribe()
+	p.wsClient.Close()
+	p.wsClient, p.sub, p.heads = nil, nil, nil
+}
+
+// drain drops the heads buffered while the producer was busy, the safe head was polled after them.
+func (p *Producer) drain() {
+	for {
+		select {
+		case <-p.heads:
+		default:
+			return
+		}
+	}
+}
+
+// safeHeadAt returns the safe head given a new chain head.
+func (p *Producer) safeHeadAt(ctx context.Context, header *types.Header) (*big.Int, error) {
+	if p.finality != FinalityLatest {
+		return p.SafeHead(ctx)
+	}
+
+	safe := new(big.Int).Sub(header.Number, big.NewInt(p.confirmations))
+	if safe.Sign() < 0 {
+		safe.SetInt64(0)
+	}
+
+	p.log.Debug("new head", zap.Int64("number", header.Number.Int64()))
+	return safe, nil
+}
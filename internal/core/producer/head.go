@@ -0,0 +1,80 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.uber.org/zap"
+	"math/big"
+	"time"
+)
+
+// SafeHead returns the newest block the producer is allowed to process:
+// the safe or finalized block in tag mode, otherwise the head minus confirmations.
+func (p *Producer) SafeHead(ctx context.Context) (*big.Int, error) {
+	switch p.finality {
+	case FinalitySafe, FinalityFinalized:
+		tag := rpc.SafeBlockNumber
+		if p.finality == FinalityFinalized {
+			tag = rpc.FinalizedBlockNumber
+		}
+
+		header, err := p.client.HeaderByNumber(ctx, big.NewInt(tag.Int64()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s block: %w", p.finality, err)
+		}
+		return header.Number, nil
+	}
+
+	head, err := p.client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get head: %w", err)
+	}
+
+	safe := int64(head) - p.confirmations
+	if safe < 0 {
+		safe = 0
+	}
+
+	return big.NewInt(safe), nil
+}
+
+// waitFor blocks until the block is at or below the safe head.
+// Once caught up it follows new heads over the websocket upstream if there is one
+// and falls back to polling when the subscription fails. It unsubscribes when it finds the producer far behind.
+// It returns false if the producer was stopped meanwhile.
+func (p *Producer) waitFor(ctx context.Context, num *big.Int) (bool, error) {
+	for num.Cmp(p.head) > 0 {
+		head, err := p.SafeHead(ctx)
+		if err != nil {
+			return true, err
+		}
+		p.head = head
+
+		if num.Cmp(p.head) <= 0 {
+			// heads would pile up unread while catching up, so the subscription is dropped until caught up
+			if new(big.Int).Sub(p.head, num).Cmp(big.NewInt(followBuffer)) >= 0 {
+				p.unsubscribe()
+			}
+			break
+		}
+
+		if p.followURL != "" {
+			running, err := p.follow(ctx, num)
+			if err == nil {
+				return running, nil
+			}
+			p.log.Warn("failed to follow new heads, polling", zap.Error(err))
+		}
+
+		p.log.Debug("waiting for confirmations", zap.Int64("number", num.Int64()), zap.Int64("safe", head.Int64()))
+
+		select {
+		case <-p.done:
+			return false, nil
+		case <-time.After(p.pollInterval):
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,167 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+	"math/big"
+	"strings"
+)
+
+// maxRangeMoves is how many times a range is read again when the chain moves meanwhile.
+const maxRangeMoves = 3
+
+var (
+	transferTopics = []common.Hash{
+		common.HexToHash(transfer),
+		common.HexToHash(transferSingle),
+		common.HexToHash(transferBatch),
+	}
+	upgradeTopics = []common.Hash{
+		common.HexToHash(upgraded),
+		common.HexToHash(beaconUpgraded),
+	}
+	watchedTopics = append(append([]common.Hash{}, transferTopics...), upgradeTopics...)
+
+	errRangeTooLarge = errors.New("log range rejected by upstream")
+	// errRangeMoved means the chain was reorganised while the range was read, it is read again.
+	errRangeMoved = errors.New("chain moved while reading the range")
+)
+
+// scanLogs filters transfer and upgrade logs from a range of blocks starting at from, bounded by the safe head.
+// It returns every block of the range with its hash and parent hash, so the whole range is tracked for reorganisations.
+func (p *Producer) scanLogs(ctx context.Context, from *big.Int) ([]*found, error) {
+	moved := 0
+	for {
+		to := new(big.Int).Add(from, big.NewInt(p.logRange-1))
+		if to.Cmp(p.head) > 0 {
+			to.Set(p.head)
+		}
+
+		segment, err := p.filterRange(ctx, from, to)
+		if errors.Is(err, errRangeTooLarge) && p.logRange > 1 {
+			p.logRange /= 2
+			p.log.Debug("shrinking log range", zap.Int64("range", p.logRange), zap.Error(err))
+			continue
+		}
+		if errors.Is(err, errRangeMoved) && moved < maxRangeMoves {
+			moved++
+			p.log.Debug("reading the range again", zap.Error(err))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if p.logRange < p.maxLogRange {
+			p.logRange = min(p.logRange*2, p.maxLogRange)
+		}
+
+		return segment, nil
+	}
+}
+
+func (p *Producer) filterRange(ctx context.Context, from, to *big.Int) ([]*found, error) {
+	logs, err := p.filterLogs(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := p.client.HeadersByRange(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get headers [%s, %s]: %w", from, to, err)
+	}
+
+	return p.segment(headers, logs)
+}
+
+// segment turns every block of the range into a found block and sorts the logs into them.
+// It fails with errRangeMoved if the headers do not link up or a log belongs to another fork than its header.
+func (p *Producer) segment(headers []*types.Header, logs []types.Log) ([]*found, error) {
+	if len(headers) == 0 {
+		return nil, errors.New("no headers in the range")
+	}
+
+	segment := make([]*found, len(headers))
+	for i, header := range headers {
+		if i > 0 && header.ParentHash != headers[i-1].Hash() {
+			return nil, fmt.Errorf("%w: block %s does not extend its parent", errRangeMoved, header.Number)
+		}
+		segment[i] = &found{block: blockOf(header)}
+	}
+
+	first := headers[0].Number.Uint64()
+	seen := make(map[string]struct{})
+	for i := range logs {
+		logEntry := &logs[i]
+		if logEntry.Removed {
+			continue
+		}
+
+		if logEntry.BlockNumber < first || logEntry.BlockNumber-first >= uint64(len(segment)) {
+			return nil, fmt.Errorf("log of block %d is out of the range", logEntry.BlockNumber)
+		}
+		f := segment[logEntry.BlockNumber-first]
+		if f.block.Hash != logEntry.BlockHash.Hex() {
+			return nil, fmt.Errorf("%w: log of block %d is of another fork", errRangeMoved, logEntry.BlockNumber)
+		}
+
+		if u := p.upgrade(logEntry); u != nil {
+			f.upgrades = append(f.upgrades, u)
+			continue
+		}
+
+		c := p.candidate(logEntry)
+		if c == nil {
+			continue
+		}
+		key := f.block.Number.String() + c.Address
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		f.contracts = append(f.contracts, c)
+	}
+
+	return segment, nil
+}
+
+func (p *Producer) filterLogs(ctx context.Context, from, to *big.Int) ([]types.Log, error) {
+	logs, err := p.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: from,
+		ToBlock:   to,
+		Topics:    [][]common.Hash{watchedTopics},
+	})
+	if err != nil {
+		if rangeRejected(err) {
+			return nil, fmt.Errorf("%w: %s", errRangeTooLarge, err)
+		}
+		return nil, fmt.Errorf("failed to filter logs [%s, %s]: %w", from, to, err)
+	}
+
+	return logs, nil
+}
+
+func blockOf(header *types.Header) *entity.Block {
+	return &entity.Block{
+		Number:     header.Number,
+		Hash:       header.Hash().Hex(),
+		ParentHash: header.ParentHash.Hex(),
+	}
+}
+
+// rangeRejected reports whether the upstream refused the query because of its range or result size.
+func rangeRejected(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{"block range", "range is too large", "query returned more than", "limit exceeded", "too many", "response size", "query timeout"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package producer
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+	"math/big"
+	"testing"
+)
+
+func TestProducer_segment(t *testing.T) {
+	p := NewProducer(nil, zap.NewNop(), "sepolia", Settings{})
+
+	headers := make([]*types.Header, 3)
+	for i := range headers {
+		headers[i] = &types.Header{Number: big.NewInt(int64(10 + i)), Difficulty: new(big.Int)}
+		if i > 0 {
+			headers[i].ParentHash = headers[i-1].Hash()
+		}
+	}
+	mint := types.Log{
+		Address:     common.HexToAddress("0x1"),
+		Topics:      []common.Hash{common.HexToHash(transfer), {}, {}, {}},
+		BlockNumber: 11,
+		BlockHash:   headers[1].Hash(),
+	}
+
+	segment, err := p.segment(headers, []types.Log{mint, mint})
+	if err != nil {
+		t.Fatalf("segment() error = %v", err)
+	}
+
+	// blocks without logs are produced too, each one linked to its parent
+	if len(segment) != len(headers) {
+		t.Fatalf("segment() = %d blocks, want %d", len(segment), len(headers))
+	}
+	for i, f := range segment {
+		if f.block.Hash != headers[i].Hash().Hex() || f.block.ParentHash != headers[i].ParentHash.Hex() {
+			t.Errorf("block %s has hash %s and parent %s", f.block.Number, f.block.Hash, f.block.ParentHash)
+		}
+	}
+	if len(segment[1].contracts) != 1 || len(segment[0].contracts)+len(segment[2].contracts) != 0 {
+		t.Errorf("contracts = %d, %d, %d, want 0, 1, 0", len(segment[0].contracts), len(segment[1].contracts), len(segment[2].contracts))
+	}
+
+	orphaned := mint
+	orphaned.BlockHash = common.HexToHash("0xdead")
+	if _, err := p.segment(headers, []types.Log{orphaned}); !errors.Is(err, errRangeMoved) {
+		t.Errorf("segment() of an orphaned log error = %v, want %v", err, errRangeMoved)
+	}
+
+	headers[2].ParentHash = common.HexToHash("0xdead")
+	if _, err := p.segment(headers, nil); !errors.Is(err, errRangeMoved) {
+		t.Errorf("segment() of unlinked headers error = %v, want %v", err, errRangeMoved)
+	}
+}
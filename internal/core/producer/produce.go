@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum/core/types"
 	"go.uber.org/zap"
 	"math/big"
+	"sync"
 )
 
 const (
@@ -15,83 +17,266 @@ const (
 	// ERC-1155
 	transferSingle = "0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62"
 	transferBatch  = "0x4a39dc06d4c0dbc64b70af90fd698a233a518a4cb44f16935b4b89f1de659520"
+
+	// EIP-1967 proxies and beacons
+	upgraded       = "0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b"
+	beaconUpgraded = "0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e"
 )
 
-func (p *Producer) Produce(lastBlockNumber *big.Int, handled chan struct{}) (chan *big.Int, chan *entity.Contract, chan error) {
-	blocks := make(chan *big.Int)
-	contracts := make(chan *entity.Contract)
-	errCh := make(chan error)
+// found is a scanned block together with the candidate contracts and proxy upgrades seen in it.
+type found struct {
+	block     *entity.Block
+	contracts []*entity.Contract
+	upgrades  []*entity.Upgrade
+}
+
+func (p *Producer) Produce(lastBlockNumber *big.Int, known []*entity.Block, handled chan struct{}) *entity.Stream {
+	stream := &entity.Stream{
+		Blocks:    make(chan *entity.Block),
+		Contracts: make(chan *entity.Contract),
+		Tokens:    make(chan *entity.Contract),
+		Upgrades:  make(chan *entity.Upgrade),
+		Reorgs:    make(chan *entity.Reorg),
+		Errors:    make(chan error),
+	}
 	one := big.NewInt(1)
 
+	// the previous run must be over before its state is reused
+	p.running.Wait()
+
+	p.Lock()
+	ctx, cancel := context.WithCancel(context.Background())
+	p.done, p.cancel, p.stopOnce = make(chan struct{}), cancel, sync.Once{}
+	p.Unlock()
+
+	for _, block := range known {
+		p.remember(block)
+	}
+	// the supervisor of the previous run may have dropped what it was handed
+	p.known.Purge()
+
+	p.running.Add(1)
 	go func() {
+		defer p.running.Done()
+		defer p.close(stream)
+
 		blockNumber := lastBlockNumber
 		for {
 			select {
 			case <-p.done:
-				close(blocks)
-				close(contracts)
-				close(errCh)
 				return
 			case <-handled:
-				block, err := p.client.BlockByNumber(context.Background(), blockNumber)
+			}
+
+			if p.stopped() {
+				return
+			}
+
+			running, err := p.waitFor(ctx, blockNumber)
+			if err != nil {
+				send(p.done, stream.Errors, fmt.Errorf("failed to get safe head: %w", err))
+				return
+			}
+			if !running {
+				return
+			}
+
+			segment, err := p.scan(ctx, blockNumber)
+			if err != nil {
+				send(p.done, stream.Errors, err)
+				return
+			}
+
+			if first := segment[0].block; p.reorged(first) {
+				from, err := p.forkPoint(ctx, first.Number)
 				if err != nil {
-					errCh <- fmt.Errorf("failed to get block: %w", err)
+					send(p.done, stream.Errors, fmt.Errorf("failed to find fork point: %w", err))
+					return
+				}
+
+				p.log.Warn("chain reorganisation", zap.Int64("from", from.Int64()), zap.Int64("at", first.Number.Int64()))
+
+				p.forget(from)
+				// contracts of the orphaned blocks are rolled back and have to be produced again
+				p.known.Purge()
+				if !send(p.done, stream.Reorgs, &entity.Reorg{From: from, Depth: new(big.Int).Sub(first.Number, from).Int64()}) {
 					return
 				}
+				blockNumber.Set(from)
+				continue
+			}
+
+			for i, f := range segment {
+				if i > 0 {
+					select {
+					case <-p.done:
+						return
+					case <-handled:
+					}
+				}
+
+				p.log.Debug("new block", zap.Int64("number", f.block.Number.Int64()))
+
+				for _, c := range f.contracts {
+					if p.known.Contains(c.Address) {
+						continue
+					}
 
-				p.log.Debug("new block", zap.Int64("number", block.Number().Int64()))
+					ch := stream.Contracts
+					if c.Type == entity.ERC20Type {
+						ch = stream.Tokens
+					}
 
-				for _, tx := range block.Transactions() {
-					receipt, err := p.client.TransactionReceipt(context.Background(), tx.Hash())
-					if err != nil {
-						p.log.Error("failed to get transaction receipt:", zap.Error(err))
+					// remembered before it is handed over so an exception made for it is not overridden
+					p.known.Add(c.Address, struct{}{})
+					if !send(p.done, ch, c) {
 						return
 					}
+				}
 
-					for _, logEntry := range receipt.Logs {
-						if len(logEntry.Topics) < 1 {
-							continue
-						}
-
-						switch logEntry.Topics[0].Hex() {
-						case transferSingle, transferBatch:
-							if p.excepted(logEntry.Address.String()) {
-								continue
-							}
-
-							c := &entity.Contract{
-								Network: p.network,
-								ChainID: entity.Atoi[p.network],
-								Address: logEntry.Address.String(),
-								Type:    entity.ERC1155Type,
-							}
-							c.Found(block.Number())
-							contracts <- c
-						case transfer:
-							if p.excepted(logEntry.Address.String()) {
-								continue
-							}
-
-							c := &entity.Contract{
-								Network: p.network,
-								ChainID: entity.Atoi[p.network],
-								Address: logEntry.Address.String(),
-								Type:    entity.ERC721Type,
-							}
-							c.Found(block.Number())
-							contracts <- c
-						}
+				for _, u := range f.upgrades {
+					if !send(p.done, stream.Upgrades, u) {
+						return
 					}
 				}
-				blocks <- block.Number()
+
+				p.remember(f.block)
+				if !send(p.done, stream.Blocks, f.block) {
+					return
+				}
 			}
-			blockNumber.Add(blockNumber, one)
+
+			blockNumber.Add(segment[len(segment)-1].block.Number, one)
 		}
 	}()
 
-	return blocks, contracts, errCh
+	return stream
+}
+
+// scan reads the blocks starting from the given one in the configured mode.
+// The first scanned block always carries its parent hash.
+func (p *Producer) scan(ctx context.Context, from *big.Int) ([]*found, error) {
+	if p.scanMode == ScanLogs {
+		return p.scanLogs(ctx, from)
+	}
+
+	f, err := p.scanReceipts(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*found{f}, nil
+}
+
+// transferType tells the standard of a Transfer log by its layout.
+// ERC-721 indexes the token id, ERC-20 keeps the value in data, and early ERC-721 contracts
+// such as CryptoKitties index nothing at all.
+func transferType(logEntry *types.Log) string {
+	switch {
+	case len(logEntry.Topics) == 4 && len(logEntry.Data) == 0:
+		return entity.ERC721Type
+	case len(logEntry.Topics) == 3 && len(logEntry.Data) == 32:
+		return entity.ERC20Type
+	case len(logEntry.Topics) == 1 && len(logEntry.Data) == 96:
+		return entity.ERC721Type
+	default:
+		return entity.UnknownType
+	}
+}
+
+// candidate turns a transfer-like log into a contract to check, or returns nil.
+// ERC-20 contracts are candidates too, they are produced on their own channel.
+func (p *Producer) candidate(logEntry *types.Log) *entity.Contract {
+	if len(logEntry.Topics) < 1 {
+		return nil
+	}
+
+	var _type string
+	switch logEntry.Topics[0].Hex() {
+	case transferSingle, transferBatch:
+		_type = entity.ERC1155Type
+	case transfer:
+		_type = transferType(logEntry)
+	default:
+		return nil
+	}
+	if _type == entity.UnknownType {
+		return nil
+	}
+
+	if p.Excepted(logEntry.Address.String()) {
+		return nil
+	}
+
+	c := &entity.Contract{
+		Network: p.network,
+		ChainID: entity.Atoi[p.network],
+		Address: logEntry.Address.String(),
+		Type:    _type,
+	}
+	c.Found(new(big.Int).SetUint64(logEntry.BlockNumber))
+
+	return c
+}
+
+// upgrade turns an Upgraded or BeaconUpgraded log into the upgrade of its emitter, or returns nil.
+func (p *Producer) upgrade(logEntry *types.Log) *entity.Upgrade {
+	if len(logEntry.Topics) != 2 {
+		return nil
+	}
+
+	switch topic := logEntry.Topics[0].Hex(); topic {
+	case upgraded, beaconUpgraded:
+		return &entity.Upgrade{
+			Address: logEntry.Address.String(),
+			Block:   new(big.Int).SetUint64(logEntry.BlockNumber),
+			Beacon:  topic == beaconUpgraded,
+		}
+	default:
+		return nil
+	}
+}
+
+func (p *Producer) close(stream *entity.Stream) {
+	p.unsubscribe()
+
+	close(stream.Blocks)
+	close(stream.Contracts)
+	close(stream.Tokens)
+	close(stream.Upgrades)
+	close(stream.Reorgs)
+	close(stream.Errors)
 }
 
+// Stop makes Produce return before the next block and cancels requests in flight.
+// It is safe to call more than once, Produce may be called again afterwards.
 func (p *Producer) Stop() {
-	close(p.done)
+	p.Lock()
+	defer p.Unlock()
+
+	p.stopOnce.Do(func() {
+		close(p.done)
+		if p.cancel != nil {
+			p.cancel()
+		}
+	})
+}
+
+func (p *Producer) stopped() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// send delivers v unless the producer is stopped first.
+func send[T any](done chan struct{}, ch chan T, v T) bool {
+	select {
+	case <-done:
+		return false
+	case ch <- v:
+		return true
+	}
 }
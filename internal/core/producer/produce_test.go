@@ -0,0 +1,31 @@
+package producer
+
+import (
+	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"testing"
+)
+
+func Test_transferType(t *testing.T) {
+	topic := common.HexToHash(transfer)
+	from, to, id := common.HexToHash("0x1"), common.HexToHash("0x2"), common.HexToHash("0x3")
+
+	tests := []struct {
+		name string
+		log  *types.Log
+		want string
+	}{
+		{name: "erc721", log: &types.Log{Topics: []common.Hash{topic, from, to, id}}, want: entity.ERC721Type},
+		{name: "erc20", log: &types.Log{Topics: []common.Hash{topic, from, to}, Data: make([]byte, 32)}, want: entity.ERC20Type},
+		{name: "unindexed erc721", log: &types.Log{Topics: []common.Hash{topic}, Data: make([]byte, 96)}, want: entity.ERC721Type},
+		{name: "irregular", log: &types.Log{Topics: []common.Hash{topic, from}, Data: make([]byte, 64)}, want: entity.UnknownType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transferType(tt.log); got != tt.want {
+				t.Errorf("transferType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
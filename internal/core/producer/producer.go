@@ -1,23 +1,127 @@
 package producer
 
 import (
+	"context"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	i "git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"go.uber.org/zap"
+	"math/big"
 	"sync"
+	"time"
 )
 
+const (
+	FinalityLatest    = "latest"
+	FinalitySafe      = "safe"
+	FinalityFinalized = "finalized"
+
+	// ScanReceipts reads every transaction receipt of every block.
+	ScanReceipts = "receipts"
+	// ScanLogs filters transfer logs over block ranges.
+	ScanLogs = "logs"
+
+	defaultPollInterval = 4 * time.Second
+	defaultLogRange     = 1000
+
+	defaultKnownAddresses = 1 << 16
+)
+
+// Settings tunes how the producer walks the chain.
+type Settings struct {
+	// ReorgDepth is how many recent blocks are tracked to detect reorganisations.
+	ReorgDepth int64
+	// Confirmations is how many blocks the producer stays behind the head in the latest mode.
+	Confirmations int64
+	// Finality is the block tag the producer never advances past: latest, safe or finalized.
+	Finality string
+	// PollInterval is how long the producer waits for a new safe head once caught up.
+	PollInterval time.Duration
+	// ScanMode is how blocks are searched for transfers: receipts or logs.
+	ScanMode string
+	// LogRange is the largest block range a single log filter may cover in the logs mode.
+	LogRange int64
+	// FollowURL is a websocket upstream used to follow new heads once caught up.
+	// The producer polls when it is empty.
+	FollowURL string
+	// KnownAddresses bounds how many produced contracts are remembered not to be produced again.
+	KnownAddresses int
+}
+
 type Producer struct {
 	network string
 
 	log        *zap.Logger
-	client     *ethclient.Client
-	exceptions map[string]struct{}
+	client     i.Client
+	exceptions map[string]time.Time
+	// known are the contracts already produced in this run, they are not produced again.
+	known *lru.Cache[string, struct{}]
+
+	// chain holds the recently produced canonical blocks by number, at most reorgDepth of them.
+	chain      map[int64]*entity.Block
+	reorgDepth int64
+
+	confirmations int64
+	finality      string
+	pollInterval  time.Duration
+	// head is the last known safe head, the producer does not go past it.
+	head *big.Int
 
-	done chan struct{}
+	scanMode string
+	// logRange adapts to the upstream limits, it never exceeds maxLogRange.
+	logRange    int64
+	maxLogRange int64
+
+	followURL string
+	wsClient  *ethclient.Client
+	sub       ethereum.Subscription
+	heads     chan *types.Header
+
+	// done is closed and cancel is called to stop the current run of Produce.
+	done     chan struct{}
+	cancel   context.CancelFunc
+	stopOnce sync.Once
+	running  sync.WaitGroup
 
 	sync.RWMutex
 }
 
-func NewProducer(client *ethclient.Client, log *zap.Logger, network string) *Producer {
-	return &Producer{client: client, log: log, done: make(chan struct{}), network: network, exceptions: make(map[string]struct{})}
+func NewProducer(client i.Client, log *zap.Logger, network string, settings Settings) *Producer {
+	if settings.PollInterval <= 0 {
+		settings.PollInterval = defaultPollInterval
+	}
+	if settings.Finality == "" {
+		settings.Finality = FinalityLatest
+	}
+	if settings.ScanMode == "" {
+		settings.ScanMode = ScanReceipts
+	}
+	if settings.LogRange <= 0 {
+		settings.LogRange = defaultLogRange
+	}
+	if settings.KnownAddresses <= 0 {
+		settings.KnownAddresses = defaultKnownAddresses
+	}
+
+	return &Producer{
+		client:        client,
+		log:           log,
+		done:          make(chan struct{}),
+		network:       network,
+		exceptions:    make(map[string]time.Time),
+		known:         lru.NewCache[string, struct{}](settings.KnownAddresses),
+		chain:         make(map[int64]*entity.Block),
+		reorgDepth:    settings.ReorgDepth,
+		confirmations: settings.Confirmations,
+		finality:      settings.Finality,
+		pollInterval:  settings.PollInterval,
+		head:          new(big.Int),
+		scanMode:      settings.ScanMode,
+		logRange:      settings.LogRange,
+		maxLogRange:   settings.LogRange,
+		followURL:     settings.FollowURL,
+	}
 }
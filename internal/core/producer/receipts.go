@@ -0,0 +1,49 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// scanReceipts fetches the block and the receipts of all its transactions.
+func (p *Producer) scanReceipts(ctx context.Context, num *big.Int) (*found, error) {
+	block, err := p.client.BlockByNumber(ctx, num)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block: %w", err)
+	}
+
+	f := &found{block: blockOf(block.Header())}
+	if !mayMatter(block.Bloom()) {
+		return f, nil
+	}
+
+	receipts, err := p.client.BlockReceipts(ctx, block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block receipts: %w", err)
+	}
+
+	for _, receipt := range receipts {
+		for _, logEntry := range receipt.Logs {
+			if u := p.upgrade(logEntry); u != nil {
+				f.upgrades = append(f.upgrades, u)
+			} else if c := p.candidate(logEntry); c != nil {
+				f.contracts = append(f.contracts, c)
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// mayMatter reports whether the logs bloom of a block lets it contain a transfer or upgrade log.
+// A bloom has false positives only, so a block it rules out has none of them for sure.
+func mayMatter(bloom types.Bloom) bool {
+	for _, topic := range watchedTopics {
+		if bloom.Test(topic.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
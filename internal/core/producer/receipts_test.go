@@ -0,0 +1,36 @@
+package producer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"testing"
+)
+
+func Test_mayMatter(t *testing.T) {
+	bloom := func(topics ...string) types.Bloom {
+		var b types.Bloom
+		for _, topic := range topics {
+			b.Add(common.HexToHash(topic).Bytes())
+		}
+		return b
+	}
+
+	tests := []struct {
+		name  string
+		bloom types.Bloom
+		want  bool
+	}{
+		{name: "empty", bloom: bloom(), want: false},
+		{name: "transfer", bloom: bloom(transfer), want: true},
+		{name: "transfer batch", bloom: bloom(transferBatch), want: true},
+		{name: "beacon upgraded", bloom: bloom(beaconUpgraded), want: true},
+		{name: "other event", bloom: bloom("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mayMatter(tt.bloom); got != tt.want {
+				t.Errorf("mayMatter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"math/big"
+)
+
+// remember adds the block to the tracked canonical chain and drops blocks
+// that are too old to be reorganised.
+func (p *Producer) remember(block *entity.Block) {
+	num := block.Number.Int64()
+	p.chain[num] = block
+
+	for n := range p.chain {
+		if n <= num-p.reorgDepth {
+			delete(p.chain, n)
+		}
+	}
+}
+
+// forget drops every tracked block starting from the given number.
+func (p *Producer) forget(from *big.Int) {
+	for n := range p.chain {
+		if n >= from.Int64() {
+			delete(p.chain, n)
+		}
+	}
+}
+
+// reorged reports whether the block does not extend the tracked chain.
+// Blocks whose parent is not tracked are trusted.
+func (p *Producer) reorged(block *entity.Block) bool {
+	parent, ok := p.chain[block.Number.Int64()-1]
+	if !ok {
+		return false
+	}
+
+	return parent.Hash != block.ParentHash
+}
+
+// forkPoint walks the tracked chain back from the block below num and returns
+// the number of the first block which is no longer canonical.
+func (p *Producer) forkPoint(ctx context.Context, num *big.Int) (*big.Int, error) {
+	for n := num.Int64() - 1; n > num.Int64()-1-p.reorgDepth && n >= 0; n-- {
+		tracked, ok := p.chain[n]
+		if !ok {
+			continue
+		}
+
+		header, err := p.client.HeaderByNumber(ctx, big.NewInt(n))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get header %d: %w", n, err)
+		}
+
+		if header.Hash().Hex() == tracked.Hash {
+			return big.NewInt(n + 1), nil
+		}
+	}
+
+	return nil, fmt.Errorf("block %s: %w of %d blocks", num, entity.ErrDeepReorg, p.reorgDepth)
+}
@@ -0,0 +1,54 @@
+package producer
+
+import (
+	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"go.uber.org/zap"
+	"math/big"
+	"testing"
+)
+
+func block(num int64, hash, parent string) *entity.Block {
+	return &entity.Block{Number: big.NewInt(num), Hash: hash, ParentHash: parent}
+}
+
+func TestProducer_reorged(t *testing.T) {
+	p := NewProducer(nil, zap.NewNop(), "sepolia", Settings{ReorgDepth: 3})
+	p.remember(block(10, "0xa", "0x9"))
+	p.remember(block(11, "0xb", "0xa"))
+
+	tests := []struct {
+		name  string
+		block *entity.Block
+		want  bool
+	}{
+		{name: "extends chain", block: block(12, "0xc", "0xb"), want: false},
+		{name: "other parent", block: block(12, "0xc2", "0xb2"), want: true},
+		{name: "unknown parent", block: block(20, "0xd", "0xe"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.reorged(tt.block); got != tt.want {
+				t.Errorf("reorged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProducer_remember(t *testing.T) {
+	p := NewProducer(nil, zap.NewNop(), "sepolia", Settings{ReorgDepth: 3})
+	for i := int64(1); i <= 5; i++ {
+		p.remember(block(i, "", ""))
+	}
+
+	if len(p.chain) != 3 {
+		t.Fatalf("tracked %d blocks, want 3", len(p.chain))
+	}
+	if _, ok := p.chain[2]; ok {
+		t.Errorf("block 2 is still tracked")
+	}
+
+	p.forget(big.NewInt(4))
+	if len(p.chain) != 1 {
+		t.Errorf("tracked %d blocks after forget, want 1", len(p.chain))
+	}
+}
@@ -0,0 +1,66 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"math/big"
+)
+
+// Scan returns the candidate contracts found in the blocks [from, to] in the order they were seen.
+// It does not touch the state of Produce, so it is safe to call concurrently.
+func (p *Producer) Scan(ctx context.Context, from, to *big.Int) ([]*entity.Contract, error) {
+	var contracts []*entity.Contract
+	one := big.NewInt(1)
+
+	if p.scanMode != ScanLogs {
+		for num := new(big.Int).Set(from); num.Cmp(to) <= 0; num.Add(num, one) {
+			f, err := p.scanReceipts(ctx, num)
+			if err != nil {
+				return nil, err
+			}
+			contracts = append(contracts, f.contracts...)
+		}
+		return contracts, nil
+	}
+
+	step := p.maxLogRange
+	seen := make(map[string]struct{})
+	for start := new(big.Int).Set(from); start.Cmp(to) <= 0; {
+		end := new(big.Int).Add(start, big.NewInt(step-1))
+		if end.Cmp(to) > 0 {
+			end.Set(to)
+		}
+
+		logs, err := p.filterLogs(ctx, start, end)
+		if errors.Is(err, errRangeTooLarge) && step > 1 {
+			step /= 2
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range logs {
+			if logs[i].Removed {
+				continue
+			}
+
+			c := p.candidate(&logs[i])
+			if c == nil {
+				continue
+			}
+
+			key := c.FoundAt().String() + c.Address
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			contracts = append(contracts, c)
+		}
+
+		start.Add(end, one)
+	}
+
+	return contracts, nil
+}
@@ -0,0 +1,66 @@
+package rpcpool
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"math/big"
+)
+
+func (p *Pool) BlockNumber(ctx context.Context) (uint64, error) {
+	return call(ctx, p, "eth_blockNumber", func(c *ethclient.Client) (uint64, error) {
+		return c.BlockNumber(ctx)
+	})
+}
+
+func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return call(ctx, p, "eth_getBlockByNumber", func(c *ethclient.Client) (*types.Block, error) {
+		return c.BlockByNumber(ctx, number)
+	})
+}
+
+func (p *Pool) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return call(ctx, p, "eth_getBlockByNumber", func(c *ethclient.Client) (*types.Header, error) {
+		return c.HeaderByNumber(ctx, number)
+	})
+}
+
+func (p *Pool) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return call(ctx, p, "eth_getTransactionReceipt", func(c *ethclient.Client) (*types.Receipt, error) {
+		return c.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (p *Pool) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return call(ctx, p, "eth_getLogs", func(c *ethclient.Client) ([]types.Log, error) {
+		return c.FilterLogs(ctx, q)
+	})
+}
+
+func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, p, "eth_call", func(c *ethclient.Client) ([]byte, error) {
+		return c.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+func (p *Pool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, p, "eth_getCode", func(c *ethclient.Client) ([]byte, error) {
+		return c.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+func (p *Pool) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, p, "eth_getStorageAt", func(c *ethclient.Client) ([]byte, error) {
+		return c.StorageAt(ctx, account, key, blockNumber)
+	})
+}
+
+// CallContext performs a JSON-RPC call the client has no method for, such as debug_traceBlockByNumber.
+func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	_, err := call(ctx, p, method, func(c *ethclient.Client) (struct{}, error) {
+		return struct{}{}, c.Client().CallContext(ctx, result, method, args...)
+	})
+	return err
+}
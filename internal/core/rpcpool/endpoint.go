@@ -0,0 +1,109 @@
+package rpcpool
+
+import (
+	"context"
+	"fmt"
+	"git.web3gate.ru/web3/nft/GraphForge/pkg/limiter"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"sync"
+	"time"
+)
+
+const (
+	// breakerThreshold consecutive failures open the circuit of an endpoint.
+	breakerThreshold = 3
+	// breakerCooldown is how long an open circuit rejects calls before a trial call is let through.
+	breakerCooldown = 30 * time.Second
+)
+
+type capability int
+
+const (
+	unknown capability = iota
+	supported
+	unsupported
+)
+
+type endpoint struct {
+	url string
+
+	client  *ethclient.Client
+	limiter *limiter.Limiter
+	// latency is a moving average of successful calls.
+	latency time.Duration
+	head    uint64
+	healthy bool
+
+	failures  int
+	openUntil time.Time
+
+	// blockReceipts is whether the endpoint serves eth_getBlockReceipts, it is probed on first use.
+	blockReceipts capability
+
+	sync.Mutex
+}
+
+// dial returns the client of the endpoint connecting it on first use.
+func (e *endpoint) dial(ctx context.Context) (*ethclient.Client, error) {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.client != nil {
+		return e.client, nil
+	}
+
+	client, err := ethclient.DialContext(ctx, e.url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial: %w", err)
+	}
+	e.client = client
+
+	return client, nil
+}
+
+func (e *endpoint) success(took time.Duration) {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.latency == 0 {
+		e.latency = took
+	} else {
+		e.latency = (e.latency*4 + took) / 5
+	}
+	e.failures = 0
+	e.openUntil = time.Time{}
+	e.healthy = true
+}
+
+func (e *endpoint) failure() {
+	e.Lock()
+	defer e.Unlock()
+
+	e.failures++
+	e.healthy = false
+	if e.failures >= breakerThreshold {
+		e.openUntil = time.Now().Add(breakerCooldown)
+	}
+}
+
+// available reports whether the circuit lets calls through, and whether it is a trial call.
+func (e *endpoint) available(now time.Time) (ok, trial bool) {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.failures < breakerThreshold {
+		return true, false
+	}
+	return now.After(e.openUntil), true
+}
+
+func (e *endpoint) close() {
+	e.Lock()
+	defer e.Unlock()
+
+	e.limiter.Close()
+	if e.client != nil {
+		e.client.Close()
+		e.client = nil
+	}
+}
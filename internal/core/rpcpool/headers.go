@@ -0,0 +1,60 @@
+package rpcpool
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+)
+
+// headersBatch is how many eth_getBlockByNumber calls go in one batch request.
+const headersBatch = 100
+
+// HeadersByRange returns the headers of the blocks [from, to] in their order.
+// The whole range is read from the same upstream with batches of eth_getBlockByNumber.
+func (p *Pool) HeadersByRange(ctx context.Context, from, to *big.Int) ([]*types.Header, error) {
+	return call(ctx, p, "eth_getBlockByNumber", func(c *ethclient.Client) ([]*types.Header, error) {
+		return batchHeaders(ctx, c.Client(), from, to)
+	})
+}
+
+func batchHeaders(ctx context.Context, c *rpc.Client, from, to *big.Int) ([]*types.Header, error) {
+	count := new(big.Int).Sub(to, from).Int64() + 1
+	if count <= 0 {
+		return nil, nil
+	}
+	headers := make([]*types.Header, count)
+
+	for start := int64(0); start < count; start += headersBatch {
+		end := min(start+headersBatch, count)
+
+		batch := make([]rpc.BatchElem, 0, end-start)
+		for i := start; i < end; i++ {
+			batch = append(batch, rpc.BatchElem{
+				Method: "eth_getBlockByNumber",
+				Args:   []any{hexutil.EncodeBig(new(big.Int).Add(from, big.NewInt(i))), false},
+				Result: &headers[i],
+			})
+		}
+
+		if err := c.BatchCallContext(ctx, batch); err != nil {
+			return nil, err
+		}
+
+		for i, elem := range batch {
+			num := new(big.Int).Add(from, big.NewInt(start+int64(i)))
+			if elem.Error != nil {
+				return nil, fmt.Errorf("header %s: %w", num, elem.Error)
+			}
+			if headers[start+int64(i)] == nil {
+				return nil, fmt.Errorf("header %s: %w", num, ethereum.NotFound)
+			}
+		}
+	}
+
+	return headers, nil
+}
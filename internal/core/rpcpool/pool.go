@@ -0,0 +1,260 @@
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"git.web3gate.ru/web3/nft/GraphForge/pkg/limiter"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.uber.org/zap"
+	"net"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	healthInterval = 15 * time.Second
+	healthTimeout  = 5 * time.Second
+	// maxLag is how many blocks an endpoint may fall behind the best one and still be healthy.
+	maxLag = 5
+)
+
+var ErrNoUpstream = fmt.Errorf("no upstream available: %w", ent.ErrUpstreamDown)
+
+// Limits cap the requests to every upstream of a pool, zero values are not limited.
+type Limits struct {
+	RPS         float64
+	Concurrency int
+}
+
+// Pool spreads calls of a network over several upstreams.
+// It prefers healthy endpoints with the lowest latency, fails over to the next one
+// on transport errors and keeps failing endpoints out behind a circuit breaker.
+type Pool struct {
+	network   string
+	endpoints []*endpoint
+
+	log *zap.Logger
+
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+func NewPool(network string, urls []string, limits Limits, log *zap.Logger) *Pool {
+	p := &Pool{
+		network: network,
+		log:     log.With(zap.String("pool", network)),
+		done:    make(chan struct{}),
+	}
+	for _, url := range urls {
+		p.endpoints = append(p.endpoints, &endpoint{
+			url:     url,
+			healthy: true,
+			limiter: limiter.New(network+" "+redact(url), limits.RPS, limits.Concurrency),
+		})
+	}
+
+	p.check()
+	go p.watch()
+
+	return p
+}
+
+// Close stops health checks and closes the connections.
+func (p *Pool) Close() {
+	p.stopOnce.Do(func() {
+		close(p.done)
+		for _, e := range p.endpoints {
+			e.close()
+		}
+	})
+}
+
+func (p *Pool) watch() {
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			p.check()
+		}
+	}
+}
+
+// check probes every endpoint with eth_blockNumber and marks the ones lagging behind as unhealthy.
+func (p *Pool) check() {
+	var wg sync.WaitGroup
+	for _, e := range p.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), healthTimeout)
+			defer cancel()
+
+			client, err := e.dial(ctx)
+			if err != nil {
+				e.failure()
+				p.log.Warn("upstream is down", zap.String("url", redact(e.url)), zap.Error(err))
+				return
+			}
+
+			start := time.Now()
+			head, err := client.BlockNumber(ctx)
+			if err != nil {
+				e.failure()
+				p.log.Warn("upstream is unhealthy", zap.String("url", redact(e.url)), zap.Error(err))
+				return
+			}
+
+			e.success(time.Since(start))
+			e.Lock()
+			e.head = head
+			e.Unlock()
+		}(e)
+	}
+	wg.Wait()
+
+	var best uint64
+	for _, e := range p.endpoints {
+		e.Lock()
+		best = max(best, e.head)
+		e.Unlock()
+	}
+	for _, e := range p.endpoints {
+		e.Lock()
+		if e.healthy && e.head+maxLag < best {
+			e.healthy = false
+			p.log.Warn("upstream is lagging", zap.String("url", redact(e.url)), zap.Uint64("head", e.head), zap.Uint64("best", best))
+		}
+		e.Unlock()
+	}
+}
+
+// candidates returns the endpoints to try in order: healthy ones by latency,
+// then the unhealthy ones, then the ones due for a trial call.
+func (p *Pool) candidates() []*endpoint {
+	type rank struct {
+		e       *endpoint
+		group   int
+		latency time.Duration
+	}
+
+	now := time.Now()
+	ranks := make([]rank, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		ok, trial := e.available(now)
+		if !ok {
+			continue
+		}
+
+		e.Lock()
+		r := rank{e: e, latency: e.latency}
+		switch {
+		case trial:
+			r.group = 2
+		case !e.healthy:
+			r.group = 1
+		}
+		e.Unlock()
+
+		ranks = append(ranks, r)
+	}
+
+	slices.SortStableFunc(ranks, func(a, b rank) int {
+		if a.group != b.group {
+			return a.group - b.group
+		}
+		return int(a.latency - b.latency)
+	})
+
+	endpoints := make([]*endpoint, 0, len(ranks))
+	for _, r := range ranks {
+		endpoints = append(endpoints, r.e)
+	}
+	return endpoints
+}
+
+// call runs fn against the best endpoint and fails over to the next ones on upstream errors.
+// Every attempt waits for the limiter of its endpoint with the priority carried by ctx.
+func call[T any](ctx context.Context, p *Pool, method string, fn func(client *ethclient.Client) (T, error)) (T, error) {
+	return callEndpoint(ctx, p, method, func(_ *endpoint, client *ethclient.Client) (T, error) {
+		return fn(client)
+	})
+}
+
+// callEndpoint is call for functions that depend on what the endpoint supports.
+func callEndpoint[T any](ctx context.Context, p *Pool, method string, fn func(e *endpoint, client *ethclient.Client) (T, error)) (T, error) {
+	var zero T
+	lastErr := ErrNoUpstream
+
+	for _, e := range p.candidates() {
+		client, err := e.dial(ctx)
+		if err != nil {
+			e.failure()
+			lastErr = err
+			continue
+		}
+
+		release, err := e.limiter.Acquire(ctx)
+		if err != nil {
+			return zero, fmt.Errorf("%s on %s: %w", method, p.network, err)
+		}
+
+		start := time.Now()
+		v, err := fn(e, client)
+		release()
+		if err != nil && ctx.Err() == nil && upstreamFailed(err) {
+			e.failure()
+			lastErr = err
+			p.log.Debug("failing over", zap.String("method", method), zap.String("url", redact(e.url)), zap.Error(err))
+			continue
+		}
+
+		e.success(time.Since(start))
+		return v, err
+	}
+
+	return zero, fmt.Errorf("%s on %s: %w", method, p.network, lastErr)
+}
+
+// upstreamFailed reports whether the error is caused by the endpoint rather than by the request,
+// so the call is worth repeating on another endpoint.
+func upstreamFailed(err error) bool {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == 429 || httpErr.StatusCode >= 500
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	if errors.Is(err, ethereum.NotFound) || errors.Is(err, rpc.ErrNoResult) {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// redact drops the path of an upstream url which usually carries an access key.
+func redact(url string) string {
+	for i, slashes := 0, 0; i < len(url); i++ {
+		if url[i] == '/' {
+			slashes++
+		}
+		if slashes == 3 {
+			return url[:i]
+		}
+	}
+	return url
+}
@@ -0,0 +1,69 @@
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	"testing"
+	"time"
+)
+
+type jsonError struct{}
+
+func (jsonError) Error() string  { return "execution reverted" }
+func (jsonError) ErrorCode() int { return 3 }
+
+func Test_upstreamFailed(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "rate limited", err: rpc.HTTPError{StatusCode: 429}, want: true},
+		{name: "bad gateway", err: rpc.HTTPError{StatusCode: 502}, want: true},
+		{name: "bad request", err: rpc.HTTPError{StatusCode: 400}, want: false},
+		{name: "timeout", err: context.DeadlineExceeded, want: true},
+		{name: "json-rpc error", err: jsonError{}, want: false},
+		{name: "not found", err: ethereum.NotFound, want: false},
+		{name: "unknown", err: errors.New("EOF"), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := upstreamFailed(tt.err); got != tt.want {
+				t.Errorf("upstreamFailed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPool_candidates(t *testing.T) {
+	slow := &endpoint{url: "slow", healthy: true, latency: 300 * time.Millisecond}
+	fast := &endpoint{url: "fast", healthy: true, latency: 50 * time.Millisecond}
+	lagging := &endpoint{url: "lagging", healthy: false, latency: 10 * time.Millisecond}
+	broken := &endpoint{url: "broken", failures: breakerThreshold, openUntil: time.Now().Add(time.Minute)}
+	cooled := &endpoint{url: "cooled", failures: breakerThreshold, openUntil: time.Now().Add(-time.Second)}
+
+	p := &Pool{endpoints: []*endpoint{broken, slow, cooled, lagging, fast}}
+
+	var got []string
+	for _, e := range p.candidates() {
+		got = append(got, e.url)
+	}
+
+	want := []string{"fast", "slow", "lagging", "cooled"}
+	if len(got) != len(want) {
+		t.Fatalf("candidates() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("candidates() = %v, want %v", got, want)
+		}
+	}
+}
+
+func Test_redact(t *testing.T) {
+	if got := redact("https://node.example.com:8545/secret-key"); got != "https://node.example.com:8545" {
+		t.Errorf("redact() = %v", got)
+	}
+}
@@ -0,0 +1,113 @@
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.uber.org/zap"
+	"strings"
+)
+
+const (
+	// receiptsBatch is how many eth_getTransactionReceipt calls go in one batch request.
+	receiptsBatch = 100
+
+	methodNotFound = -32601
+)
+
+// BlockReceipts returns the receipts of all transactions of the block in their order.
+// It uses eth_getBlockReceipts where the upstream serves it and batches single receipt calls otherwise.
+func (p *Pool) BlockReceipts(ctx context.Context, block *types.Block) ([]*types.Receipt, error) {
+	if len(block.Transactions()) == 0 {
+		return nil, nil
+	}
+
+	return callEndpoint(ctx, p, "eth_getBlockReceipts", func(e *endpoint, c *ethclient.Client) ([]*types.Receipt, error) {
+		e.Lock()
+		capable := e.blockReceipts
+		e.Unlock()
+
+		if capable != unsupported {
+			receipts, err := c.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(block.Hash(), false))
+			switch {
+			case err == nil && len(receipts) == len(block.Transactions()):
+				e.probed(supported)
+				return receipts, nil
+			case err == nil:
+				p.log.Warn("eth_getBlockReceipts returned an incomplete block, batching receipts instead",
+					zap.String("url", redact(e.url)), zap.Int("receipts", len(receipts)), zap.Int("transactions", len(block.Transactions())))
+				e.probed(unsupported)
+			case unsupportedMethod(err):
+				p.log.Info("eth_getBlockReceipts is not supported, batching receipts instead", zap.String("url", redact(e.url)), zap.Error(err))
+				e.probed(unsupported)
+			default:
+				return nil, err
+			}
+		}
+
+		return batchReceipts(ctx, c.Client(), block.Transactions())
+	})
+}
+
+// batchReceipts fetches the receipts of the transactions with batches of eth_getTransactionReceipt.
+func batchReceipts(ctx context.Context, c *rpc.Client, txs types.Transactions) ([]*types.Receipt, error) {
+	receipts := make([]*types.Receipt, len(txs))
+
+	for start := 0; start < len(txs); start += receiptsBatch {
+		end := min(start+receiptsBatch, len(txs))
+
+		batch := make([]rpc.BatchElem, 0, end-start)
+		for i := start; i < end; i++ {
+			batch = append(batch, rpc.BatchElem{
+				Method: "eth_getTransactionReceipt",
+				Args:   []any{txs[i].Hash()},
+				Result: &receipts[i],
+			})
+		}
+
+		if err := c.BatchCallContext(ctx, batch); err != nil {
+			return nil, err
+		}
+
+		for i, elem := range batch {
+			if elem.Error != nil {
+				return nil, fmt.Errorf("receipt of %s: %w", txs[start+i].Hash(), elem.Error)
+			}
+			if receipts[start+i] == nil {
+				return nil, fmt.Errorf("receipt of %s: %w", txs[start+i].Hash(), ethereum.NotFound)
+			}
+		}
+	}
+
+	return receipts, nil
+}
+
+func (e *endpoint) probed(c capability) {
+	e.Lock()
+	defer e.Unlock()
+
+	e.blockReceipts = c
+}
+
+// unsupportedMethod reports whether the upstream rejected the method itself rather than the call.
+func unsupportedMethod(err error) bool {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.ErrorCode() == methodNotFound {
+		return true
+	}
+
+	msg := strings.ToLower(rpcErr.Error())
+	for _, s := range []string{"not supported", "does not exist", "not available", "unsupported"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package rpcpool
+
+import (
+	"errors"
+	"testing"
+)
+
+type methodError struct {
+	code int
+	msg  string
+}
+
+func (e methodError) Error() string  { return e.msg }
+func (e methodError) ErrorCode() int { return e.code }
+
+func Test_unsupportedMethod(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "method not found", err: methodError{code: methodNotFound, msg: "the method eth_getBlockReceipts does not exist/is not available"}, want: true},
+		{name: "provider message", err: methodError{code: -32000, msg: "Method not supported"}, want: true},
+		{name: "execution error", err: jsonError{}, want: false},
+		{name: "transport error", err: errors.New("connection reset by peer"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unsupportedMethod(tt.err); got != tt.want {
+				t.Errorf("unsupportedMethod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
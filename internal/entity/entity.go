@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -8,6 +9,7 @@ import (
 
 type (
 	Deployment struct {
+		ContractAddress  string `json:"contractAddress"`
 		ContractCreator  string `json:"contractCreator"`
 		TxHash           string `json:"txHash"`
 		BlockNumber      string `json:"blockNumber"`
@@ -25,6 +27,103 @@ type (
 		Address    string
 		Type       string
 		Deployment *Deployment
+		// Proxy is the proxy the contract was resolved as while its type was detected, nil if it was not.
+		Proxy *Proxy
+	}
+
+	Block struct {
+		Number     *big.Int
+		Hash       string
+		ParentHash string
+	}
+
+	// Reorg tells that every block starting from From was orphaned by a chain reorganisation.
+	Reorg struct {
+		From  *big.Int
+		Depth int64
+	}
+
+	// Upgrade tells that the proxy or beacon at Address changed its implementation at Block.
+	Upgrade struct {
+		Address string
+		Block   *big.Int
+		// Beacon tells that the proxy at Address switched to another beacon.
+		Beacon bool
+	}
+
+	// Stream groups the channels a producer feeds the supervisor with.
+	// Contracts carries NFT candidates and Tokens carries ERC-20 contracts.
+	Stream struct {
+		Blocks    chan *Block
+		Contracts chan *Contract
+		Tokens    chan *Contract
+		Upgrades  chan *Upgrade
+		Reorgs    chan *Reorg
+		Errors    chan error
+	}
+
+	// ProxyKind tells how a proxy finds the implementation it delegates to.
+	ProxyKind string
+
+	// Proxy links a proxy contract to its implementation.
+	// Beacon is set only for beacon proxies, which read the implementation from it.
+	Proxy struct {
+		ChainID        int64
+		Address        string
+		Implementation string
+		Beacon         string
+		Kind           ProxyKind
+		CheckedAt      time.Time
+	}
+
+	// NetworkState is the lifecycle state of a network supervisor.
+	NetworkState string
+
+	// ExceptionReason tells why a contract is skipped.
+	ExceptionReason string
+
+	// DetectionReason tells what evidence a detected contract type rests on.
+	DetectionReason string
+
+	// Detection is the type of a contract together with the evidence for it.
+	Detection struct {
+		Type   string
+		Reason DetectionReason
+		// Proxy is set if the type was looked for behind the contract as a proxy.
+		Proxy *Proxy
+	}
+
+	// Exception is a contract the indexer skips until ExpiresAt, when it is evaluated again.
+	Exception struct {
+		ChainID   int64
+		Address   string
+		Reason    ExceptionReason
+		FirstSeen *big.Int
+		CheckedAt time.Time
+		ExpiresAt time.Time
+	}
+
+	// QueuedContract is a discovered contract waiting to be registered, it stays queued until it is
+	// registered or rejected. A failed attempt puts it off until NextAttempt.
+	QueuedContract struct {
+		Contract    *Contract
+		Attempts    int
+		NextAttempt time.Time
+		LastError   string
+	}
+
+	// Metadata is what a collection tells about itself through its view functions.
+	// The fields it does not implement are empty, RoyaltyBps is the ERC-2981 royalty in basis points.
+	Metadata struct {
+		ChainID         int64
+		Address         string
+		Name            string
+		Symbol          string
+		TotalSupply     *big.Int
+		ContractURI     string
+		RoyaltyReceiver string
+		RoyaltyBps      *big.Int
+		UpdatedAt       time.Time
 	}
 
 	AppBlock struct {
@@ -37,10 +136,6 @@ type (
 		AppBlockID int
 		ContractID int
 	}
-
-	EtherScanResponse struct {
-		Result []Deployment `json:"result"`
-	}
 )
 
 const (
@@ -49,22 +144,43 @@ const (
 	ERC1155Type = "ERC1155"
 	UnknownType = "Unknown"
 
+	StateRunning    NetworkState = "running"
+	StateBackingOff NetworkState = "backing-off"
+	StateFailed     NetworkState = "failed"
+	StateStopped    NetworkState = "stopped"
+
+	// DetectedByERC165 means the contract claims the interface with supportsInterface.
+	DetectedByERC165 DetectionReason = "erc165"
+	// DetectedByLogs means the contract emitted the events of the standard near the block it was found at.
+	DetectedByLogs DetectionReason = "transfer_logs"
+	// DetectedByProbes means the contract answers the view functions of the standard.
+	DetectedByProbes DetectionReason = "view_probes"
+	NotDetected      DetectionReason = "no_evidence"
+
+	ReasonNotERC721      ExceptionReason = "not_erc721"
+	ReasonNotERC20       ExceptionReason = "not_erc20"
+	ReasonNoExplorerData ExceptionReason = "no_explorer_data"
+	ReasonUnknownType    ExceptionReason = "unknown_type"
+	ReasonNotContract    ExceptionReason = "not_contract"
+	// ReasonUntrackedERC20 skips ERC-20 contracts on a network that does not track tokens.
+	ReasonUntrackedERC20 ExceptionReason = "erc20_untracked"
+
+	// ProxyEIP1967 keeps the implementation in the EIP-1967 slot, ProxyUUPS is such a proxy
+	// whose implementation is upgradeable itself as EIP-1822 describes.
+	ProxyEIP1967 ProxyKind = "eip1967"
+	ProxyUUPS    ProxyKind = "uups"
+	ProxyBeacon  ProxyKind = "beacon"
+	// ProxyEIP1822 keeps the implementation in the legacy PROXIABLE slot.
+	ProxyEIP1822 ProxyKind = "eip1822"
+	// ProxyClone is an EIP-1167 minimal proxy with the implementation in its bytecode.
+	ProxyClone ProxyKind = "eip1167"
+
 	MAINNET int64 = 1
 	SEPOLIA int64 = 11155111
 	HOLESKY int64 = 17000
-
-	ETH_MAINNET_ADDRESS = "https://api.etherscan.io/api"
-	ETH_SEPOLIA_ADDRESS = "https://api-sepolia.etherscan.io/api"
-	ETH_HOLESKY_ADDRESS = "https://api-holesky.etherscan.io/api"
 )
 
 var (
-	EtherScanKeys = map[int64]string{
-		MAINNET: ETH_SEPOLIA_ADDRESS,
-		SEPOLIA: ETH_SEPOLIA_ADDRESS,
-		HOLESKY: ETH_HOLESKY_ADDRESS,
-	}
-
 	Atoi = map[string]int64{
 		"mainnet": MAINNET,
 		"sepolia": SEPOLIA,
@@ -78,8 +194,27 @@ var (
 	}
 
 	ErrNOTOK = fmt.Errorf("No data found")
+
+	ErrDeepReorg = fmt.Errorf("reorg is deeper than tracked chain")
+
+	ErrUnknownType = fmt.Errorf("unknown type of contract")
+
+	// Transient failures of looking at a contract, the same request may well succeed later.
+	ErrTimeout      = fmt.Errorf("timed out")
+	ErrRateLimited  = fmt.Errorf("rate limited")
+	ErrUpstreamDown = fmt.Errorf("upstream unavailable")
+
+	// Permanent failures of looking at a contract, repeating the request does not change the answer.
+	ErrNotContract = fmt.Errorf("not a contract")
+	ErrReverted    = fmt.Errorf("execution reverted")
+	ErrUnsupported = fmt.Errorf("unsupported")
 )
 
+// Transient reports whether the error is a failure worth retrying later.
+func Transient(err error) bool {
+	return errors.Is(err, ErrTimeout) || errors.Is(err, ErrRateLimited) || errors.Is(err, ErrUpstreamDown)
+}
+
 func (c *Contract) FoundAt() *big.Int {
 	return c.blockFoundAt
 }
@@ -15,6 +15,8 @@ type deployerServer struct {
 	dep  interfaces.Deployer
 	dec  interfaces.Detector
 	repo interfaces.Storage
+
+	networks map[string]interfaces.Network
 	g.UnimplementedSubgraphServiceServer
 }
 
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	g "git.web3gate.ru/web3/nft/GraphForge/grpc/forge"
+	"git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *deployerServer) ListExceptions(ctx context.Context, params *g.ListExceptionsRequest) (*g.ListExceptionsResponse, error) {
+	network, err := s.network(params.GetNetwork())
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions, err := network.Exceptions(ctx, params.GetAll())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exceptions: %w", err)
+	}
+
+	resp := &g.ListExceptionsResponse{Exceptions: make([]*g.Exception, 0, len(exceptions))}
+	for _, e := range exceptions {
+		exception := &g.Exception{
+			Address:   e.Address,
+			Reason:    string(e.Reason),
+			CheckedAt: e.CheckedAt.Unix(),
+			ExpiresAt: e.ExpiresAt.Unix(),
+		}
+		if e.FirstSeen != nil {
+			exception.FirstSeen = e.FirstSeen.Int64()
+		}
+		resp.Exceptions = append(resp.Exceptions, exception)
+	}
+
+	return resp, nil
+}
+
+func (s *deployerServer) ClearExceptions(ctx context.Context, params *g.ClearExceptionsRequest) (*g.ClearExceptionsResponse, error) {
+	network, err := s.network(params.GetNetwork())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case params.GetAll() && len(params.GetAddresses()) > 0:
+		return nil, status.Error(codes.InvalidArgument, "addresses cannot be given with all")
+	case !params.GetAll() && len(params.GetAddresses()) == 0:
+		return nil, status.Error(codes.InvalidArgument, "no addresses given, set all to clear every exception")
+	}
+
+	addresses := make([]string, 0, len(params.GetAddresses()))
+	for _, address := range params.GetAddresses() {
+		if !common.IsHexAddress(address) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %q", address)
+		}
+		// contracts are stored checksummed
+		addresses = append(addresses, common.HexToAddress(address).Hex())
+	}
+
+	cleared, err := network.ClearExceptions(ctx, addresses, params.GetAll())
+	if err != nil {
+		return nil, fmt.Errorf("failed to clear exceptions: %w", err)
+	}
+
+	s.log.Info("exceptions cleared", zap.String("network", params.GetNetwork()), zap.Strings("addresses", addresses), zap.Bool("all", params.GetAll()), zap.Int64("cleared", cleared))
+	return &g.ClearExceptionsResponse{Cleared: cleared}, nil
+}
+
+func (s *deployerServer) network(name string) (interfaces.Network, error) {
+	network, ok := s.networks[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown network %q", name)
+	}
+	return network, nil
+}
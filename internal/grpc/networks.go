@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+	g "git.web3gate.ru/web3/nft/GraphForge/grpc/forge"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"slices"
+	"strings"
+)
+
+// ListNetworks returns the state of every indexed network, a network that gave up reads failed.
+func (s *deployerServer) ListNetworks(_ context.Context, _ *emptypb.Empty) (*g.ListNetworksResponse, error) {
+	resp := &g.ListNetworksResponse{Networks: make([]*g.Network, 0, len(s.networks))}
+	for name, network := range s.networks {
+		resp.Networks = append(resp.Networks, &g.Network{Network: name, State: string(network.State())})
+	}
+	slices.SortFunc(resp.Networks, func(a, b *g.Network) int {
+		return strings.Compare(a.Network, b.Network)
+	})
+
+	return resp, nil
+}
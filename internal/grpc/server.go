@@ -1,19 +1,28 @@
 package grpc
 
 import (
+	"context"
 	g "git.web3gate.ru/web3/nft/GraphForge/grpc/forge"
 	"git.web3gate.ru/web3/nft/GraphForge/internal/interfaces"
+	"git.web3gate.ru/web3/nft/GraphForge/pkg/limiter"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
 
-func InitForgeGRPC(log *zap.Logger, deployer interfaces.Deployer, detector interfaces.Detector, repo interfaces.Storage) *grpc.Server {
-	s := grpc.NewServer()
+func InitForgeGRPC(log *zap.Logger, deployer interfaces.Deployer, detector interfaces.Detector, repo interfaces.Storage, networks map[string]interfaces.Network) *grpc.Server {
+	s := grpc.NewServer(grpc.UnaryInterceptor(onDemand))
 	g.RegisterSubgraphServiceServer(s, &deployerServer{
 		log:  log,
 		dep:  deployer,
 		dec:  detector,
 		repo: repo,
+
+		networks: networks,
 	})
 	return s
 }
+
+// onDemand lets upstream calls made for a client go ahead of the background work.
+func onDemand(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(limiter.WithPriority(ctx, limiter.OnDemand), req)
+}
@@ -3,32 +3,122 @@ package interfaces
 import (
 	"context"
 	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"math/big"
+	"time"
 )
 
 type (
+	// Client is the part of the Ethereum JSON-RPC API the indexer relies on.
+	Client interface {
+		BlockNumber(ctx context.Context) (uint64, error)
+		BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+		HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+		// HeadersByRange returns the headers of the blocks [from, to] in their order.
+		HeadersByRange(ctx context.Context, from, to *big.Int) ([]*types.Header, error)
+		TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+		// BlockReceipts returns the receipts of all transactions of the block in their order.
+		BlockReceipts(ctx context.Context, block *types.Block) ([]*types.Receipt, error)
+		FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+		CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+		CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+		StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+		// CallContext performs a raw JSON-RPC call, it is meant for the methods above the standard API.
+		CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+	}
+
+	Scanner interface {
+		Scan(ctx context.Context, from, to *big.Int) ([]*ent.Contract, error)
+	}
+
 	Producer interface {
-		Produce(lastBlockNumber *big.Int, handled chan struct{}) (chan *big.Int, chan *ent.Contract, chan error)
+		Scanner
+		Produce(lastBlockNumber *big.Int, known []*ent.Block, handled chan struct{}) *ent.Stream
+		SafeHead(ctx context.Context) (*big.Int, error)
 		Stop()
-		Exception(contract string)
+		// Exception skips the contract until the given time, Forgive stops skipping the contracts and ForgiveAll all of them.
+		Exception(contract string, until time.Time)
+		Forgive(contracts ...string)
+		ForgiveAll()
+		Excepted(contract string) bool
 	}
 
 	Graph interface {
 		RealExist() map[string]struct{}
-		Init(contract string) error
+		Init(contract, _type string) error
 		Create(contract string) error
 		Deploy(contract string) error
 	}
 
 	Storage interface {
 		SaveContractForge(ctx context.Context, num, contractID int64) error
-		SaveBlock(ctx context.Context, num *big.Int, chainID int64) (int64, error)
+		SaveBlock(ctx context.Context, block *ent.Block, chainID int64) (int64, error)
 		BlockHandled(ctx context.Context, num *big.Int, chainID int64) error
+		RecentBlocks(ctx context.Context, chainID int64, limit int) ([]*ent.Block, error)
+		Rollback(ctx context.Context, chainID int64, from *big.Int) ([]string, error)
 
-		LastBlock(chainID int64) (*big.Int, error)
+		LastBlock(chainID int64, safeHead *big.Int) (*big.Int, error)
+		FirstBlock(ctx context.Context, chainID int64) (*big.Int, error)
 		Initialized(ctx context.Context, contract *ent.Contract) bool
 
 		SaveContract(ctx context.Context, dep *ent.Contract) (contractID int64, err error)
+
+		BackfillProgress(ctx context.Context, chainID int64, from, to *big.Int) (map[int64]int64, error)
+		SaveBackfillChunk(ctx context.Context, chainID, from, to int64, contracts int) error
+
+		SaveException(ctx context.Context, exception *ent.Exception) error
+		Exceptions(ctx context.Context, chainID int64, all bool) ([]*ent.Exception, error)
+		ClearExceptions(ctx context.Context, chainID int64, addresses []string, all bool) (int64, error)
+
+		SaveProxy(ctx context.Context, proxy *ent.Proxy) error
+		// Proxies returns the proxies of the chain at the address or reading their implementation from it.
+		Proxies(ctx context.Context, chainID int64, address string) ([]*ent.Proxy, error)
+
+		SaveMetadata(ctx context.Context, metadata *ent.Metadata) error
+		// StaleMetadata returns up to limit contracts whose metadata is missing or older than before.
+		StaleMetadata(ctx context.Context, chainID int64, before time.Time, limit int) ([]*ent.Contract, error)
+
+		// EnqueueContracts keeps the discovered contracts until they are registered or rejected,
+		// they are leased to the caller for lease.
+		EnqueueContracts(ctx context.Context, contracts []*ent.Contract, lease time.Duration) error
+		// ClaimContracts leases up to limit queued contracts that are due.
+		ClaimContracts(ctx context.Context, chainID int64, limit int, lease time.Duration) ([]*ent.QueuedContract, error)
+		// RescheduleContract reports whether the contract was dead-lettered for failing maxAttempts times.
+		RescheduleContract(ctx context.Context, chainID int64, address, lastError string, next time.Time, maxAttempts int) (bool, error)
+		DequeueContracts(ctx context.Context, chainID int64, addresses []string) error
+	}
+
+	// Exceptions are the contracts a network skips.
+	Exceptions interface {
+		Exceptions(ctx context.Context, all bool) ([]*ent.Exception, error)
+		ClearExceptions(ctx context.Context, addresses []string, all bool) (int64, error)
+	}
+
+	// Network is an indexed network as the API manages it.
+	Network interface {
+		Exceptions
+		State() ent.NetworkState
+	}
+
+	Backfiller interface {
+		Backfill(ctx context.Context, from, to *big.Int, handle func(ctx context.Context, contracts []*ent.Contract) error) error
+	}
+
+	// DeploymentSource is a block explorer that knows how contracts were deployed.
+	DeploymentSource interface {
+		Name() string
+		// Deployment returns the deployment of the contract, it fails with ErrNOTOK if the source does not know it.
+		Deployment(ctx context.Context, chainID int64, address string) (*ent.Deployment, error)
+	}
+
+	// BatchDeploymentSource is a DeploymentSource that looks up many contracts at once.
+	BatchDeploymentSource interface {
+		DeploymentSource
+		// Deployments returns the deployments it knows by the given addresses,
+		// the ones found before a failure are returned along with it.
+		Deployments(ctx context.Context, chainID int64, addresses []string) (map[string]*ent.Deployment, error)
 	}
 
 	Deployer interface {
@@ -37,7 +127,18 @@ type (
 
 	Detector interface {
 		IsERC721(ctx context.Context, contract *ent.Contract) bool
+		IsERC20(ctx context.Context, contract *ent.Contract) bool
+		// Confirm tells for each candidate whether it implements the standard its transfer logs hint at,
+		// the error of a candidate is nil if it does and ErrUnsupported if it does not.
+		Confirm(ctx context.Context, contracts []*ent.Contract) []error
 		Type(ctx context.Context, contract *ent.Contract) (string, error)
+		// Detect is Type together with the evidence the type rests on.
+		Detect(ctx context.Context, contract *ent.Contract) (ent.Detection, error)
 		LoadInfo(ctx context.Context, contract *ent.Contract) error
+		// LoadInfos is LoadInfo for many contracts, it returns the error of each of them.
+		LoadInfos(ctx context.Context, contracts []*ent.Contract) []error
+		// ResolveProxy returns the implementation the contract delegates to, or nil if it is not a proxy.
+		ResolveProxy(ctx context.Context, contract *ent.Contract) (*ent.Proxy, error)
+		Metadata(ctx context.Context, contract *ent.Contract) (*ent.Metadata, error)
 	}
 )
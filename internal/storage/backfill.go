@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// BackfillProgress returns how far every backfill chunk starting within [from, to] was scanned, by chunk start.
+func (s *storage) BackfillProgress(ctx context.Context, chainID int64, from, to *big.Int) (map[int64]int64, error) {
+	const op = "storage.BackfillProgress"
+
+	rows, err := s.db.QueryContext(ctx, `select from_block, scanned_to from nft.forge_backfill_chunk where chain_id = $1 and from_block between $2 and $3`, chainID, from.Int64(), to.Int64())
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to select: %w", op, err)
+	}
+	defer rows.Close()
+
+	progress := make(map[int64]int64)
+	for rows.Next() {
+		var start, scanned int64
+		if err := rows.Scan(&start, &scanned); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan: %w", op, err)
+		}
+		progress[start] = scanned
+	}
+
+	return progress, rows.Err()
+}
+
+func (s *storage) SaveBackfillChunk(ctx context.Context, chainID, from, to int64, contracts int) error {
+	const op = "storage.SaveBackfillChunk"
+
+	query := `INSERT INTO nft.forge_backfill_chunk (chain_id, from_block, scanned_to, contracts, updated_at) values($1, $2, $3, $4, now())
+		on conflict (chain_id, from_block) do update set
+			scanned_to = greatest(nft.forge_backfill_chunk.scanned_to, excluded.scanned_to),
+			contracts = excluded.contracts,
+			updated_at = now()`
+	if _, err := s.db.ExecContext(ctx, query, chainID, from, to, contracts); err != nil {
+		return fmt.Errorf("%s: failed to upsert: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"math/big"
+)
+
+// SaveException records the contract as skipped, the first block it was seen at is kept.
+func (s *storage) SaveException(ctx context.Context, exception *ent.Exception) error {
+	const op = "storage.SaveException"
+
+	var firstSeen sql.NullInt64
+	if exception.FirstSeen != nil {
+		firstSeen = sql.NullInt64{Int64: exception.FirstSeen.Int64(), Valid: true}
+	}
+
+	query := `INSERT INTO nft.forge_exception (chain_id, address, reason, first_seen, checked_at, expires_at) values($1, $2, $3, $4, $5, $6)
+		on conflict (chain_id, address) do update set
+			reason = excluded.reason,
+			first_seen = coalesce(least(nft.forge_exception.first_seen, excluded.first_seen), nft.forge_exception.first_seen, excluded.first_seen),
+			checked_at = excluded.checked_at,
+			expires_at = excluded.expires_at`
+	if _, err := s.db.ExecContext(ctx, query, exception.ChainID, exception.Address, exception.Reason, firstSeen, exception.CheckedAt, exception.ExpiresAt); err != nil {
+		return fmt.Errorf("%s: failed to upsert: %w", op, err)
+	}
+
+	return nil
+}
+
+// Exceptions returns the exceptions of the chain, the expired ones only if all is set.
+func (s *storage) Exceptions(ctx context.Context, chainID int64, all bool) ([]*ent.Exception, error) {
+	const op = "storage.Exceptions"
+
+	rows, err := s.db.QueryContext(ctx, `select address, reason, first_seen, checked_at, expires_at from nft.forge_exception
+		where chain_id = $1 and ($2 or expires_at > now()) order by checked_at`, chainID, all)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to select: %w", op, err)
+	}
+	defer rows.Close()
+
+	var exceptions []*ent.Exception
+	for rows.Next() {
+		var firstSeen sql.NullInt64
+		e := &ent.Exception{ChainID: chainID}
+		if err := rows.Scan(&e.Address, &e.Reason, &firstSeen, &e.CheckedAt, &e.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan: %w", op, err)
+		}
+		if firstSeen.Valid {
+			e.FirstSeen = big.NewInt(firstSeen.Int64)
+		}
+		exceptions = append(exceptions, e)
+	}
+
+	return exceptions, rows.Err()
+}
+
+// ClearExceptions deletes the exceptions of the given addresses, or all exceptions of the chain if all is set.
+func (s *storage) ClearExceptions(ctx context.Context, chainID int64, addresses []string, all bool) (int64, error) {
+	const op = "storage.ClearExceptions"
+
+	var (
+		res sql.Result
+		err error
+	)
+	if all {
+		res, err = s.db.ExecContext(ctx, `delete from nft.forge_exception where chain_id = $1`, chainID)
+	} else {
+		res, err = s.db.ExecContext(ctx, `delete from nft.forge_exception where chain_id = $1 and address = any($2)`, chainID, addresses)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to delete: %w", op, err)
+	}
+
+	return res.RowsAffected()
+}
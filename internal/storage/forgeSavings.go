@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
 	"math/big"
 )
 
@@ -19,13 +20,13 @@ func (s *storage) BlockHandled(ctx context.Context, num *big.Int, chainID int64)
 	return err
 }
 
-func (s *storage) SaveBlock(ctx context.Context, num *big.Int, chainID int64) (int64, error) {
+func (s *storage) SaveBlock(ctx context.Context, block *ent.Block, chainID int64) (int64, error) {
 	const op = "storage.SaveBlock"
 
 	var blockID int64
-	if err := s.db.QueryRowContext(ctx, `select id from nft.forge_block where block_number = $1 and chain_id = $2`, num.Int64(), chainID).Scan(&blockID); err != nil {
+	if err := s.db.QueryRowContext(ctx, `select id from nft.forge_block where block_number = $1 and chain_id = $2`, block.Number.Int64(), chainID).Scan(&blockID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			if err := s.db.QueryRowContext(ctx, `INSERT INTO nft.forge_block (block_number, chain_id) values($1, $2) returning id`, num.Int64(), chainID).Scan(&blockID); err != nil {
+			if err := s.db.QueryRowContext(ctx, `INSERT INTO nft.forge_block (block_number, chain_id, block_hash, parent_hash) values($1, $2, $3, $4) returning id`, block.Number.Int64(), chainID, block.Hash, block.ParentHash).Scan(&blockID); err != nil {
 				return 0, fmt.Errorf("%s: failed to insert: %w", op, err)
 			}
 			return blockID, nil
@@ -33,13 +34,25 @@ func (s *storage) SaveBlock(ctx context.Context, num *big.Int, chainID int64) (i
 		return 0, fmt.Errorf("%s: failed to check block: %w", op, err)
 	}
 
+	if block.Hash == "" {
+		return blockID, nil
+	}
+
+	if _, err := s.db.ExecContext(ctx, `update nft.forge_block set block_hash = $1, parent_hash = $2 where id = $3`, block.Hash, block.ParentHash, blockID); err != nil {
+		return 0, fmt.Errorf("%s: failed to update hash: %w", op, err)
+	}
+
 	return blockID, nil
 }
 
+// SaveContractForge attributes the contract to the forge block, a contract keeps the block it was saved with first.
+// It is safe to call concurrently for the same contract.
 func (s *storage) SaveContractForge(ctx context.Context, num, contractID int64) error {
 	const op = "storage.SaveContractForge"
 
-	_, err := s.db.ExecContext(ctx, `INSERT INTO nft.forge_deployment (forge_block_id, contract_id) values($1, $2)`, num, contractID)
+	query := `INSERT INTO nft.forge_deployment (forge_block_id, contract_id) values($1, $2)
+		on conflict (contract_id) do nothing`
+	_, err := s.db.ExecContext(ctx, query, num, contractID)
 	if err != nil {
 		return fmt.Errorf("%s: failed to insert: %w", op, err)
 	}
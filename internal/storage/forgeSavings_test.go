@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+	"math/big"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testChainID keeps the rows of the test apart from the ones of real chains.
+const testChainID = 990001
+
+// testStorage connects to the database with the migrations applied that BCMON_TEST_DSN points to.
+func testStorage(t *testing.T) *storage {
+	dsn := os.Getenv("BCMON_TEST_DSN")
+	if dsn == "" {
+		t.Skip("BCMON_TEST_DSN is not set")
+	}
+
+	db, err := sqlx.Connect("pgx", dsn)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	return &storage{db: db, log: zap.NewNop()}
+}
+
+func TestStorage_SaveContractForge(t *testing.T) {
+	s := testStorage(t)
+	ctx := context.Background()
+
+	t.Cleanup(func() {
+		_, _ = s.db.Exec(`delete from nft.forge_deployment where forge_block_id in (select id from nft.forge_block where chain_id = $1)`, testChainID)
+		_, _ = s.db.Exec(`delete from nft.forge_block where chain_id = $1`, testChainID)
+		_, _ = s.db.Exec(`delete from nft.contract where chain_id = $1`, testChainID)
+	})
+
+	contractID, err := s.SaveContract(ctx, &ent.Contract{
+		ChainID:    testChainID,
+		Address:    "0x000000000000000000000000000000000000f0F0",
+		Type:       ent.ERC721Type,
+		Deployment: &ent.Deployment{BlockNumber: "1", Timestamp: time.Unix(0, 0)},
+	})
+	if err != nil {
+		t.Fatalf("SaveContract() error = %v", err)
+	}
+
+	blocks := make([]int64, 2)
+	for i := range blocks {
+		if blocks[i], err = s.SaveBlock(ctx, &ent.Block{Number: big.NewInt(int64(i + 1))}, testChainID); err != nil {
+			t.Fatalf("SaveBlock() error = %v", err)
+		}
+	}
+
+	// the live block loop and backfill workers may save the same contract at once
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.SaveContractForge(ctx, blocks[i%2], contractID); err != nil {
+				t.Errorf("SaveContractForge() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var count int
+	if err := s.db.Get(&count, `select count(*) from nft.forge_deployment where contract_id = $1`, contractID); err != nil {
+		t.Fatalf("failed to count: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("contract saved %d times, want once", count)
+	}
+}
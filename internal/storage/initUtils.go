@@ -9,17 +9,42 @@ import (
 	"math/big"
 )
 
-func (s *storage) LastBlock(chainID int64) (*big.Int, error) {
+// LastBlock returns the block to continue from: the one after the last handled block,
+// but never past the block after safeHead. A network without handled blocks starts from safeHead.
+func (s *storage) LastBlock(chainID int64, safeHead *big.Int) (*big.Int, error) {
 	const op = "storage.LastBlock"
 
-	var blockNum int64
-	if err := s.db.QueryRowContext(context.Background(), `select block_number from nft.forge_block where is_handled = true and chain_id = $1 order by id desc limit 1`, chainID).Scan(&blockNum); err != nil {
-		return nil, fmt.Errorf("%s: failed to insert: %w", op, err)
+	var blockNum sql.NullInt64
+	if err := s.db.QueryRowContext(context.Background(), `select max(block_number) from nft.forge_block where is_handled = true and chain_id = $1`, chainID).Scan(&blockNum); err != nil {
+		return nil, fmt.Errorf("%s: failed to select: %w", op, err)
 	}
 
-	blockNum++
+	if !blockNum.Valid {
+		return new(big.Int).Set(safeHead), nil
+	}
+
+	next := big.NewInt(blockNum.Int64 + 1)
+	if ceiling := new(big.Int).Add(safeHead, big.NewInt(1)); next.Cmp(ceiling) > 0 {
+		return ceiling, nil
+	}
+
+	return next, nil
+}
+
+// FirstBlock returns the lowest handled block or nil if there is none yet.
+func (s *storage) FirstBlock(ctx context.Context, chainID int64) (*big.Int, error) {
+	const op = "storage.FirstBlock"
+
+	var blockNum sql.NullInt64
+	if err := s.db.QueryRowContext(ctx, `select min(block_number) from nft.forge_block where is_handled = true and chain_id = $1`, chainID).Scan(&blockNum); err != nil {
+		return nil, fmt.Errorf("%s: failed to select: %w", op, err)
+	}
+
+	if !blockNum.Valid {
+		return nil, nil
+	}
 
-	return big.NewInt(blockNum), nil
+	return big.NewInt(blockNum.Int64), nil
 }
 
 func (s *storage) Initialized(ctx context.Context, contract *ent.Contract) bool {
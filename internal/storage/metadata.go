@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"math/big"
+	"time"
+)
+
+// SaveMetadata stores the metadata of a saved contract, replacing the previous one.
+func (s *storage) SaveMetadata(ctx context.Context, m *ent.Metadata) error {
+	const op = "storage.SaveMetadata"
+
+	query := `INSERT INTO nft.contract_metadata (contract_id, name, symbol, total_supply, contract_uri, royalty_receiver, royalty_bps, updated_at)
+		select id, $3, $4, $5, $6, $7, $8, $9 from nft.contract where chain_id = $1 and address = $2
+		on conflict (contract_id) do update set
+			name = excluded.name,
+			symbol = excluded.symbol,
+			total_supply = excluded.total_supply,
+			contract_uri = excluded.contract_uri,
+			royalty_receiver = excluded.royalty_receiver,
+			royalty_bps = excluded.royalty_bps,
+			updated_at = excluded.updated_at`
+	res, err := s.db.ExecContext(ctx, query, m.ChainID, m.Address, m.Name, m.Symbol, numeric(m.TotalSupply), m.ContractURI,
+		sql.NullString{String: m.RoyaltyReceiver, Valid: m.RoyaltyReceiver != ""}, numeric(m.RoyaltyBps), m.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("%s: failed to upsert: %w", op, err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("%s: contract %s is not saved", op, m.Address)
+	}
+
+	return nil
+}
+
+// StaleMetadata returns up to limit contracts of the chain whose metadata is missing or was read before the given time,
+// the longest outdated first.
+func (s *storage) StaleMetadata(ctx context.Context, chainID int64, before time.Time, limit int) ([]*ent.Contract, error) {
+	const op = "storage.StaleMetadata"
+
+	rows, err := s.db.QueryContext(ctx, `select c.address, c.type from nft.contract c
+		left join nft.contract_metadata m on m.contract_id = c.id
+		where c.chain_id = $1 and (m.updated_at is null or m.updated_at < $2)
+		order by m.updated_at nulls first limit $3`, chainID, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to select: %w", op, err)
+	}
+	defer rows.Close()
+
+	var contracts []*ent.Contract
+	for rows.Next() {
+		c := &ent.Contract{Network: ent.Itoa[chainID], ChainID: chainID}
+		if err := rows.Scan(&c.Address, &c.Type); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan: %w", op, err)
+		}
+		contracts = append(contracts, c)
+	}
+
+	return contracts, rows.Err()
+}
+
+func numeric(v *big.Int) sql.NullString {
+	if v == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: v.String(), Valid: true}
+}
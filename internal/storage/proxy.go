@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+)
+
+// SaveProxy records the implementation the proxy delegates to, replacing the previous one.
+func (s *storage) SaveProxy(ctx context.Context, proxy *ent.Proxy) error {
+	const op = "storage.SaveProxy"
+
+	beacon := sql.NullString{String: proxy.Beacon, Valid: proxy.Beacon != ""}
+
+	query := `INSERT INTO nft.contract_proxy (chain_id, address, implementation, beacon, kind, checked_at) values($1, $2, $3, $4, $5, $6)
+		on conflict (chain_id, address) do update set
+			implementation = excluded.implementation,
+			beacon = excluded.beacon,
+			kind = excluded.kind,
+			checked_at = excluded.checked_at`
+	if _, err := s.db.ExecContext(ctx, query, proxy.ChainID, proxy.Address, proxy.Implementation, beacon, proxy.Kind, proxy.CheckedAt); err != nil {
+		return fmt.Errorf("%s: failed to upsert: %w", op, err)
+	}
+
+	return nil
+}
+
+// Proxies returns the proxy at the address together with the proxies that use it as their beacon.
+func (s *storage) Proxies(ctx context.Context, chainID int64, address string) ([]*ent.Proxy, error) {
+	const op = "storage.Proxies"
+
+	rows, err := s.db.QueryContext(ctx, `select address, implementation, beacon, kind, checked_at from nft.contract_proxy
+		where chain_id = $1 and (address = $2 or beacon = $2)`, chainID, address)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to select: %w", op, err)
+	}
+	defer rows.Close()
+
+	var proxies []*ent.Proxy
+	for rows.Next() {
+		var beacon sql.NullString
+		p := &ent.Proxy{ChainID: chainID}
+		if err := rows.Scan(&p.Address, &p.Implementation, &beacon, &p.Kind, &p.CheckedAt); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan: %w", op, err)
+		}
+		p.Beacon = beacon.String
+		proxies = append(proxies, p)
+	}
+
+	return proxies, rows.Err()
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"math/big"
+	"time"
+)
+
+// EnqueueContracts queues the discovered contracts for registration and leases them to the caller,
+// the queue worker does not claim them before the lease ends. A contract queued already keeps its attempts.
+func (s *storage) EnqueueContracts(ctx context.Context, contracts []*ent.Contract, lease time.Duration) error {
+	const op = "storage.EnqueueContracts"
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%s: failed to begin: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO nft.forge_contract_queue (chain_id, address, network, type, found_at, next_attempt_at)
+		values($1, $2, $3, $4, $5, now() + $6 * interval '1 second')
+		on conflict (chain_id, address) do update set
+			next_attempt_at = greatest(nft.forge_contract_queue.next_attempt_at, excluded.next_attempt_at)`
+	for _, c := range contracts {
+		if c.FoundAt() == nil {
+			return fmt.Errorf("%s: %s was not found at a block", op, c.Address)
+		}
+		if _, err := tx.ExecContext(ctx, query, c.ChainID, c.Address, c.Network, c.Type, c.FoundAt().Int64(), lease.Seconds()); err != nil {
+			return fmt.Errorf("%s: failed to insert: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return nil
+}
+
+// ClaimContracts leases up to limit queued contracts of the chain that are due, the ones waiting longest first.
+// A claimed contract is not due again until the lease ends, so concurrent claims never share a contract.
+// Dead-lettered contracts are never claimed.
+func (s *storage) ClaimContracts(ctx context.Context, chainID int64, limit int, lease time.Duration) ([]*ent.QueuedContract, error) {
+	const op = "storage.ClaimContracts"
+
+	rows, err := s.db.QueryContext(ctx, `update nft.forge_contract_queue q set next_attempt_at = now() + $3 * interval '1 second'
+		from (select chain_id, address from nft.forge_contract_queue
+			where chain_id = $1 and dead_at is null and next_attempt_at <= now()
+			order by next_attempt_at limit $2 for update skip locked) due
+		where q.chain_id = due.chain_id and q.address = due.address
+		returning q.address, q.network, q.type, q.found_at, q.attempts, q.next_attempt_at, q.last_error`, chainID, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to claim: %w", op, err)
+	}
+	defer rows.Close()
+
+	var queued []*ent.QueuedContract
+	for rows.Next() {
+		var (
+			foundAt   int64
+			lastError sql.NullString
+		)
+		c := &ent.Contract{ChainID: chainID}
+		q := &ent.QueuedContract{Contract: c}
+		if err := rows.Scan(&c.Address, &c.Network, &c.Type, &foundAt, &q.Attempts, &q.NextAttempt, &lastError); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan: %w", op, err)
+		}
+		c.Found(big.NewInt(foundAt))
+		q.LastError = lastError.String
+		queued = append(queued, q)
+	}
+
+	return queued, rows.Err()
+}
+
+// RescheduleContract counts a failed attempt to register the queued contract and puts it off until next.
+// The contract is dead-lettered once it has failed maxAttempts times, it reports whether it was.
+func (s *storage) RescheduleContract(ctx context.Context, chainID int64, address, lastError string, next time.Time, maxAttempts int) (bool, error) {
+	const op = "storage.RescheduleContract"
+
+	query := `update nft.forge_contract_queue set attempts = attempts + 1, last_error = $3, next_attempt_at = $4,
+			dead_at = case when attempts + 1 >= $5 then now() end
+		where chain_id = $1 and address = $2
+		returning dead_at is not null`
+
+	var dead bool
+	if err := s.db.QueryRowContext(ctx, query, chainID, address, lastError, next, maxAttempts).Scan(&dead); err != nil {
+		// a contract rolled back meanwhile is no longer queued
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: failed to update: %w", op, err)
+	}
+
+	return dead, nil
+}
+
+// DequeueContracts takes the contracts off the queue once they are registered or rejected.
+func (s *storage) DequeueContracts(ctx context.Context, chainID int64, addresses []string) error {
+	const op = "storage.DequeueContracts"
+
+	if _, err := s.db.ExecContext(ctx, `delete from nft.forge_contract_queue where chain_id = $1 and address = any($2)`, chainID, addresses); err != nil {
+		return fmt.Errorf("%s: failed to delete: %w", op, err)
+	}
+
+	return nil
+}
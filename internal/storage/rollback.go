@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	ent "git.web3gate.ru/web3/nft/GraphForge/internal/entity"
+	"math/big"
+)
+
+// RecentBlocks returns up to limit latest handled blocks with known hashes in ascending order.
+func (s *storage) RecentBlocks(ctx context.Context, chainID int64, limit int) ([]*ent.Block, error) {
+	const op = "storage.RecentBlocks"
+
+	query := `select block_number, block_hash, parent_hash from (
+		select block_number, block_hash, parent_hash from nft.forge_block
+		where chain_id = $1 and is_handled = true and block_hash <> ''
+		order by block_number desc limit $2
+	) b order by block_number`
+
+	rows, err := s.db.QueryContext(ctx, query, chainID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to select: %w", op, err)
+	}
+	defer rows.Close()
+
+	var blocks []*ent.Block
+	for rows.Next() {
+		var num int64
+		block := &ent.Block{}
+		if err := rows.Scan(&num, &block.Hash, &block.ParentHash); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan: %w", op, err)
+		}
+		block.Number = big.NewInt(num)
+		blocks = append(blocks, block)
+	}
+
+	return blocks, rows.Err()
+}
+
+// Rollback removes blocks starting from the given number together with the forge deployments
+// attributed to them and the contracts queued from them. It returns addresses of the contracts
+// whose deployments were removed.
+func (s *storage) Rollback(ctx context.Context, chainID int64, from *big.Int) ([]string, error) {
+	const op = "storage.Rollback"
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to begin: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var addresses []string
+	query := `delete from nft.forge_deployment d using nft.forge_block b, nft.contract c
+		where d.forge_block_id = b.id and d.contract_id = c.id and b.chain_id = $1 and b.block_number >= $2
+		returning c.address`
+	if err := tx.SelectContext(ctx, &addresses, query, chainID, from.Int64()); err != nil {
+		return nil, fmt.Errorf("%s: failed to delete deployments: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `delete from nft.forge_block where chain_id = $1 and block_number >= $2`, chainID, from.Int64()); err != nil {
+		return nil, fmt.Errorf("%s: failed to delete blocks: %w", op, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `delete from nft.forge_contract_queue where chain_id = $1 and found_at >= $2`, chainID, from.Int64()); err != nil {
+		return nil, fmt.Errorf("%s: failed to delete queued contracts: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: failed to commit: %w", op, err)
+	}
+
+	return addresses, nil
+}
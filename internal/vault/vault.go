@@ -28,7 +28,7 @@ func NewClient(address, secretId, roleId string, timeout time.Duration) *Vault {
 	})
 
 	if err != nil {
-		panic(fmt.Sprintf("cannot init client. %w", err))
+		panic(fmt.Errorf("cannot init client. %w", err))
 	}
 
 	token := getToken(secretId, roleId, client)
@@ -0,0 +1,4 @@
+drop index if exists nft.forge_block_chain_id_block_number_idx;
+
+alter table nft.forge_block drop column if exists parent_hash;
+alter table nft.forge_block drop column if exists block_hash;
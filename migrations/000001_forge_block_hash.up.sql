@@ -0,0 +1,4 @@
+alter table nft.forge_block add column if not exists block_hash text not null default '';
+alter table nft.forge_block add column if not exists parent_hash text not null default '';
+
+create index if not exists forge_block_chain_id_block_number_idx on nft.forge_block (chain_id, block_number);
@@ -0,0 +1,9 @@
+create table if not exists nft.forge_backfill_chunk
+(
+    chain_id   bigint      not null,
+    from_block bigint      not null,
+    scanned_to bigint      not null,
+    contracts  integer     not null default 0,
+    updated_at timestamptz not null default now(),
+    primary key (chain_id, from_block)
+);
@@ -0,0 +1 @@
+drop table if exists nft.forge_exception;
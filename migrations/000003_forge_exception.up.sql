@@ -0,0 +1,12 @@
+create table if not exists nft.forge_exception
+(
+    chain_id   bigint      not null,
+    address    text        not null,
+    reason     text        not null,
+    first_seen bigint,
+    checked_at timestamptz not null default now(),
+    expires_at timestamptz not null,
+    primary key (chain_id, address)
+);
+
+create index if not exists forge_exception_expires_at_idx on nft.forge_exception (chain_id, expires_at);
@@ -0,0 +1,12 @@
+create table if not exists nft.contract_proxy
+(
+    chain_id       bigint      not null,
+    address        text        not null,
+    implementation text        not null,
+    beacon         text,
+    kind           text        not null,
+    checked_at     timestamptz not null default now(),
+    primary key (chain_id, address)
+);
+
+create index if not exists contract_proxy_beacon_idx on nft.contract_proxy (chain_id, beacon) where beacon is not null;
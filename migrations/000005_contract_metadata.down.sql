@@ -0,0 +1 @@
+drop table if exists nft.contract_metadata;
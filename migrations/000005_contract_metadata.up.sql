@@ -0,0 +1,13 @@
+create table if not exists nft.contract_metadata
+(
+    contract_id      bigint      not null primary key references nft.contract (id) on delete cascade,
+    name             text        not null default '',
+    symbol           text        not null default '',
+    total_supply     numeric(78),
+    contract_uri     text        not null default '',
+    royalty_receiver text,
+    royalty_bps      numeric(78),
+    updated_at       timestamptz not null default now()
+);
+
+create index if not exists contract_metadata_updated_at_idx on nft.contract_metadata (updated_at);
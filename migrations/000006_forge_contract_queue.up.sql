@@ -0,0 +1,15 @@
+create table if not exists nft.forge_contract_queue
+(
+    chain_id        bigint      not null,
+    address         text        not null,
+    network         text        not null,
+    type            text        not null,
+    found_at        bigint      not null,
+    attempts        integer     not null default 0,
+    next_attempt_at timestamptz not null default now(),
+    last_error      text,
+    queued_at       timestamptz not null default now(),
+    primary key (chain_id, address)
+);
+
+create index if not exists forge_contract_queue_next_attempt_at_idx on nft.forge_contract_queue (chain_id, next_attempt_at);
@@ -0,0 +1 @@
+alter table nft.forge_contract_queue drop column if exists dead_at;
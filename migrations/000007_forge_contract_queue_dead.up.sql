@@ -0,0 +1 @@
+alter table nft.forge_contract_queue add column if not exists dead_at timestamptz;
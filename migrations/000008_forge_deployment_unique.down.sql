@@ -0,0 +1 @@
+drop index if exists nft.forge_deployment_contract_id_uidx;
@@ -0,0 +1,7 @@
+-- keep the earliest forge of every contract registered more than once
+delete from nft.forge_deployment a
+    using nft.forge_deployment b
+where a.contract_id = b.contract_id
+  and (a.forge_block_id > b.forge_block_id or (a.forge_block_id = b.forge_block_id and a.ctid > b.ctid));
+
+create unique index if not exists forge_deployment_contract_id_uidx on nft.forge_deployment (contract_id);
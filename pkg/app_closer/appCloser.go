@@ -37,26 +37,23 @@ func (s *AppCloser) AddCloser(closer func(), appName string) {
 	s.components = append(s.components, component{closer: closer, appName: appName})
 }
 
-// CloseAll -
+// CloseAll - closes the components one by one in reverse order of registration,
+// so a component is closed before the ones it was built on
 func (s *AppCloser) CloseAll() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	wg := &sync.WaitGroup{}
-	wg.Add(len(s.components))
-
-	for _, c := range s.components {
-		wg.Add(1)
-		go func(wg *sync.WaitGroup, c component) {
-			defer func() {
-				if r := recover(); r != nil {
-					s.logger.Info("panic recovered: CloseAll", zap.String("app name", c.appName))
-				}
-				wg.Done()
-			}()
-			c.closer()
-		}(wg, c)
+	for i := len(s.components) - 1; i >= 0; i-- {
+		s.close(s.components[i])
 	}
+}
+
+func (s *AppCloser) close(c component) {
+	defer func() {
+		if r := recover(); r != nil && s.logger != nil {
+			s.logger.Info("panic recovered: CloseAll", zap.String("app name", c.appName))
+		}
+	}()
 
-	wg.Wait()
+	c.closer()
 }
@@ -0,0 +1,214 @@
+package limiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Priority orders requests waiting for the same limiter, higher goes first.
+type Priority int
+
+const (
+	// Backfill is historical scanning that may wait.
+	Backfill Priority = iota
+	// Head is the live pipeline following the chain.
+	Head
+	// OnDemand is a request somebody waits for, such as a gRPC call.
+	OnDemand
+
+	priorities = 3
+)
+
+func (p Priority) String() string {
+	switch p {
+	case Backfill:
+		return "backfill"
+	case OnDemand:
+		return "on-demand"
+	default:
+		return "head"
+	}
+}
+
+var ErrClosed = errors.New("limiter is closed")
+
+type priorityKey struct{}
+
+// WithPriority marks the requests made with ctx with the given priority.
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityFrom returns the priority of ctx, Head by default.
+func PriorityFrom(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return p
+	}
+	return Head
+}
+
+type waiter struct {
+	ready chan struct{}
+}
+
+// Limiter caps requests per second and requests in flight.
+// Waiting requests are let through by priority, first come first served within a priority.
+type Limiter struct {
+	name string
+
+	rate  *rate.Limiter
+	slots chan struct{}
+
+	waiting [priorities][]*waiter
+	wake    chan struct{}
+
+	done      chan struct{}
+	closeOnce sync.Once
+
+	sync.Mutex
+}
+
+// New creates a limiter, rps or concurrency that is not positive is not limited.
+func New(name string, rps float64, concurrency int) *Limiter {
+	l := &Limiter{
+		name: name,
+		rate: rate.NewLimiter(rate.Inf, 0),
+		wake: make(chan struct{}, 1),
+		done: make(chan struct{}),
+	}
+	if rps > 0 {
+		l.rate = rate.NewLimiter(rate.Limit(rps), max(1, int(rps)))
+	}
+	if concurrency > 0 {
+		l.slots = make(chan struct{}, concurrency)
+	}
+
+	go l.dispatch()
+
+	return l
+}
+
+// Acquire waits for the request to be let through and returns the function to call once it is done.
+func (l *Limiter) Acquire(ctx context.Context) (func(), error) {
+	p := PriorityFrom(ctx)
+	w := &waiter{ready: make(chan struct{})}
+	start := time.Now()
+
+	l.Lock()
+	l.waiting[p] = append(l.waiting[p], w)
+	l.Unlock()
+
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+
+	select {
+	case <-w.ready:
+		observe(l.name, p, time.Since(start))
+		return l.release, nil
+	case <-ctx.Done():
+		return nil, l.abandon(p, w, ctx.Err())
+	case <-l.done:
+		return nil, l.abandon(p, w, ErrClosed)
+	}
+}
+
+// Close stops letting requests through, the waiting ones fail with ErrClosed.
+func (l *Limiter) Close() {
+	l.closeOnce.Do(func() {
+		close(l.done)
+	})
+}
+
+// abandon takes the waiter out of the queue and returns err.
+func (l *Limiter) abandon(p Priority, w *waiter, err error) error {
+	l.Lock()
+	defer l.Unlock()
+
+	for i, queued := range l.waiting[p] {
+		if queued == w {
+			l.waiting[p] = append(l.waiting[p][:i], l.waiting[p][i+1:]...)
+			return err
+		}
+	}
+
+	// it was let through meanwhile
+	l.release()
+	return err
+}
+
+func (l *Limiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// dispatch lets waiting requests through one by one as the rate and the free slots allow.
+func (l *Limiter) dispatch() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-l.done
+		cancel()
+	}()
+
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-l.wake:
+		}
+
+		for l.pending() {
+			if err := l.rate.Wait(ctx); err != nil {
+				return
+			}
+			if l.slots != nil {
+				select {
+				case <-l.done:
+					return
+				case l.slots <- struct{}{}:
+				}
+			}
+
+			if !l.next() {
+				l.release()
+			}
+		}
+	}
+}
+
+func (l *Limiter) pending() bool {
+	l.Lock()
+	defer l.Unlock()
+
+	for _, queue := range l.waiting {
+		if len(queue) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// next lets the first waiter of the highest priority through.
+func (l *Limiter) next() bool {
+	l.Lock()
+	defer l.Unlock()
+
+	for p := priorities - 1; p >= 0; p-- {
+		if len(l.waiting[p]) == 0 {
+			continue
+		}
+
+		w := l.waiting[p][0]
+		l.waiting[p] = l.waiting[p][1:]
+		close(w.ready)
+		return true
+	}
+	return false
+}
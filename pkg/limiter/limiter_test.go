@@ -0,0 +1,58 @@
+package limiter
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiter_priority(t *testing.T) {
+	l := New("test", 0, 1)
+
+	release, err := l.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	order := make(chan Priority, 2)
+	for _, p := range []Priority{Backfill, OnDemand} {
+		go func(p Priority) {
+			done, err := l.Acquire(WithPriority(context.Background(), p))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			order <- p
+			done()
+		}(p)
+	}
+
+	// let both requests queue up behind the busy slot
+	time.Sleep(50 * time.Millisecond)
+	release()
+
+	if first := <-order; first != OnDemand {
+		t.Errorf("first let through %v, want %v", first, OnDemand)
+	}
+	<-order
+}
+
+func TestLimiter_cancel(t *testing.T) {
+	l := New("test", 0, 1)
+
+	release, err := l.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := l.Acquire(ctx); err == nil {
+		t.Fatal("acquired a busy slot")
+	}
+
+	release()
+	if _, err := l.Acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,35 @@
+package limiter
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// throttledAfter is the wait above which a request counts as throttled.
+const throttledAfter = time.Millisecond
+
+var (
+	throttled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bcmon",
+		Subsystem: "limiter",
+		Name:      "throttled_total",
+		Help:      "Requests that had to wait for the limiter.",
+	}, []string{"limiter", "priority"})
+
+	waited = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bcmon",
+		Subsystem: "limiter",
+		Name:      "wait_seconds",
+		Help:      "Time requests spent waiting for the limiter.",
+		Buckets:   []float64{.001, .01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"limiter", "priority"})
+)
+
+func observe(name string, p Priority, wait time.Duration) {
+	waited.WithLabelValues(name, p.String()).Observe(wait.Seconds())
+	if wait > throttledAfter {
+		throttled.WithLabelValues(name, p.String()).Inc()
+	}
+}